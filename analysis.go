@@ -0,0 +1,97 @@
+package uuid
+
+import (
+	"slices"
+	"time"
+)
+
+// Summary describes the shapes of a set of UUIDs. See [Summarize].
+type Summary struct {
+	Count    int             // number of UUIDs
+	Versions map[Version]int // number of UUIDs per version
+	Variants map[Variant]int // number of UUIDs per variant
+	Nil, Max int             // number of Nil and Max UUIDs, also counted per version and variant
+
+	// Oldest and Newest bound the embedded timestamps of the UUIDs whose
+	// version has one; both are zero if none do.
+	Oldest, Newest time.Time
+}
+
+// Summarize counts the versions, variants, and Nil and Max UUIDs in ids and
+// finds the range of their embedded timestamps, for data-quality checks
+// that an imported dataset contains only the expected ID shapes:
+//
+//	s := uuid.Summarize(ids)
+//	if s.Versions[uuid.V7] != s.Count { ... }
+func Summarize(ids []UUID) Summary {
+	s := Summary{Count: len(ids), Versions: make(map[Version]int), Variants: make(map[Variant]int)}
+	for _, u := range ids {
+		s.Versions[u.Version()]++
+		s.Variants[u.Variant()]++
+		switch u {
+		case Nil:
+			s.Nil++
+		case Max:
+			s.Max++
+		}
+		if t, ok := u.TimeOK(); ok {
+			if s.Oldest.IsZero() || t.Before(s.Oldest) {
+				s.Oldest = t
+			}
+			if t.After(s.Newest) {
+				s.Newest = t
+			}
+		}
+	}
+	return s
+}
+
+// LocalityReport describes a set of UUIDs as database keys. See
+// [AnalyzeLocality].
+type LocalityReport struct {
+	Summary
+	Duplicates int // number of UUIDs equal to an earlier one
+
+	// Locality is the fraction of consecutive pairs, in slice (insert)
+	// order, whose positions in sorted (index) order are at most the window
+	// apart. It is close to 1 for V7 keys, whose inserts append to the end
+	// of a B-tree index, and close to 2*window/Count for random V4 keys,
+	// whose inserts touch pages all over it. It is 0 for fewer than two
+	// UUIDs.
+	Locality float64
+}
+
+// AnalyzeLocality reports the [Summary], duplicate count, and estimated
+// insert locality of ids, taken in insert order. window is the largest
+// distance in sorted order that still counts as local, roughly the number of
+// keys per index page; values below 1 are treated as 1.
+func AnalyzeLocality(ids []UUID, window int) LocalityReport {
+	r := LocalityReport{Summary: Summarize(ids)}
+	if len(ids) < 2 {
+		return r
+	}
+
+	// rank[i] is the position of ids[i] in sorted order.
+	order := make([]int, len(ids))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return Compare(ids[a], ids[b]) })
+	rank := make([]int, len(ids))
+	for pos, i := range order {
+		rank[i] = pos
+		if pos > 0 && ids[i] == ids[order[pos-1]] {
+			r.Duplicates++
+		}
+	}
+
+	window = max(window, 1)
+	local := 0
+	for i := 1; i < len(ids); i++ {
+		if d := rank[i] - rank[i-1]; d >= -window && d <= window {
+			local++
+		}
+	}
+	r.Locality = float64(local) / float64(len(ids)-1)
+	return r
+}
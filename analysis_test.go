@@ -0,0 +1,83 @@
+package uuid
+
+import (
+	"maps"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestAnalyzeLocalityV7(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		start := time.Now()
+		gen := NewGenerator()
+		ids := make([]UUID, 0, 1000)
+		for range 1000 {
+			ids = append(ids, gen.NewV7())
+			time.Sleep(time.Millisecond)
+		}
+		ids = append(ids, ids[10], ids[20])
+
+		r := AnalyzeLocality(ids, 1)
+		if r.Count != 1002 || r.Versions[V7] != 1002 || r.Duplicates != 2 {
+			t.Errorf("Count = %d, Versions = %v, Duplicates = %d", r.Count, r.Versions, r.Duplicates)
+		}
+		if !r.Oldest.Equal(start) || !r.Newest.Equal(start.Add(999*time.Millisecond)) {
+			t.Errorf("span = %v to %v, want %v to +999ms", r.Oldest, r.Newest, start)
+		}
+		if r.Locality < 0.99 {
+			t.Errorf("Locality = %v, want ≈ 1 for V7", r.Locality)
+		}
+	})
+}
+
+func TestAnalyzeLocalityV4(t *testing.T) {
+	ids := NewV4Batch(1000)
+	r := AnalyzeLocality(ids, 0) // treated as 1
+	if r.Versions[V4] != 1000 || r.Duplicates != 0 {
+		t.Errorf("Versions = %v, Duplicates = %d", r.Versions, r.Duplicates)
+	}
+	if !r.Oldest.IsZero() || !r.Newest.IsZero() {
+		t.Errorf("span = %v to %v, want zero for V4", r.Oldest, r.Newest)
+	}
+	if r.Locality > 0.1 {
+		t.Errorf("Locality = %v, want ≈ 0 for V4", r.Locality)
+	}
+}
+
+func TestAnalyzeLocalityShort(t *testing.T) {
+	r := AnalyzeLocality([]UUID{NewV7()}, 10)
+	if r.Count != 1 || r.Locality != 0 || r.Oldest.IsZero() {
+		t.Errorf("AnalyzeLocality(1 UUID) = %+v", r)
+	}
+	if r := AnalyzeLocality(nil, 10); r.Count != 0 || len(r.Versions) != 0 {
+		t.Errorf("AnalyzeLocality(nil) = %+v", r)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	ids := []UUID{
+		MustParse("018f86ba-c2bb-7000-8000-000000000001"),
+		MustParse("0190163d-8694-7000-8000-000000000001"),
+		MustParse("c232ab00-9414-11ec-b3c8-9f6bccd3b5bb"),
+		NewV4(),
+		Nil, Nil, Max,
+		MustParse("00000000-0000-4000-c000-000000000000"),
+	}
+	s := Summarize(ids)
+	if s.Count != 8 || s.Nil != 2 || s.Max != 1 {
+		t.Errorf("Count = %d, Nil = %d, Max = %d, want 8, 2, 1", s.Count, s.Nil, s.Max)
+	}
+	wantVersions := map[Version]int{V7: 2, V1: 1, V4: 2, VNil: 2, VMax: 1}
+	if !maps.Equal(s.Versions, wantVersions) {
+		t.Errorf("Versions = %v, want %v", s.Versions, wantVersions)
+	}
+	wantVariants := map[Variant]int{VariantRFC9562: 4, VariantNCS: 2, VariantFuture: 1, VariantMicrosoft: 1}
+	if !maps.Equal(s.Variants, wantVariants) {
+		t.Errorf("Variants = %v, want %v", s.Variants, wantVariants)
+	}
+	v1Time, _ := ids[2].TimeOK()
+	if !s.Oldest.Equal(v1Time) || !s.Newest.Equal(ids[1].Time()) {
+		t.Errorf("span = %v to %v, want %v to %v", s.Oldest, s.Newest, v1Time, ids[1].Time())
+	}
+}
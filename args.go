@@ -0,0 +1,62 @@
+package uuid
+
+import "strings"
+
+// ArgFormat selects the driver representation produced by [QueryArgs].
+type ArgFormat uint8
+
+// Query argument representations.
+const (
+	// ArgString encodes UUIDs as 36-character strings, like [UUID.Value].
+	ArgString ArgFormat = iota
+	// ArgBinary encodes UUIDs as their 16 raw bytes, for BINARY(16) and
+	// native uuid columns.
+	ArgBinary
+	// ArgSwappedBinary encodes UUIDs as 16 bytes with the time_low and
+	// time_high fields swapped, as written by MySQL's UUID_TO_BIN(u, 1).
+	ArgSwappedBinary
+)
+
+// QueryArgs converts ids into driver arguments in format f, for building
+// large IN (...) lists without a [driver.Valuer] call per UUID. An empty
+// IN () list is invalid SQL, so handle empty ids before building the query:
+//
+//	if len(ids) == 0 {
+//		return nil, nil
+//	}
+//	args := uuid.QueryArgs(ids, uuid.ArgBinary)
+//	rows, err := db.Query("SELECT ... WHERE id IN (?"+strings.Repeat(",?", len(ids)-1)+")", args...)
+//
+// The encoded values share a single backing allocation; []byte values are
+// capped, so appending to one does not overwrite its neighbor. QueryArgs
+// panics if f is not a known format.
+func QueryArgs(ids []UUID, f ArgFormat) []any {
+	args := make([]any, len(ids))
+	switch f {
+	case ArgString:
+		var b strings.Builder
+		b.Grow(len(ids) * 36)
+		var buf [36]byte
+		for _, u := range ids {
+			encodeHex(buf[:], u)
+			b.Write(buf[:])
+		}
+		s := b.String()
+		for i := range args {
+			args[i] = s[i*36 : (i+1)*36]
+		}
+	case ArgBinary, ArgSwappedBinary:
+		buf := make([]byte, len(ids)*16)
+		for i, u := range ids {
+			if f == ArgSwappedBinary {
+				u = swapTimeFields(u)
+			}
+			b := buf[i*16 : (i+1)*16 : (i+1)*16]
+			copy(b, u[:])
+			args[i] = b
+		}
+	default:
+		panic("uuid: unknown ArgFormat")
+	}
+	return args
+}
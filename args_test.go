@@ -0,0 +1,43 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestQueryArgs(t *testing.T) {
+	ids := []UUID{MustParse("6ccd780c-baba-1026-9564-5b8c656024db"), Max}
+
+	args := QueryArgs(ids, ArgString)
+	if len(args) != 2 || args[0] != "6ccd780c-baba-1026-9564-5b8c656024db" || args[1] != Max.String() {
+		t.Errorf("QueryArgs(ArgString) = %v", args)
+	}
+
+	args = QueryArgs(ids, ArgBinary)
+	if b, ok := args[0].([]byte); !ok || !bytes.Equal(b, ids[0][:]) || cap(b) != 16 {
+		t.Errorf("QueryArgs(ArgBinary)[0] = %#v", args[0])
+	}
+
+	// MySQL: UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1)
+	args = QueryArgs(ids, ArgSwappedBinary)
+	want := MustParse("1026baba-6ccd-780c-9564-5b8c656024db")
+	if b, ok := args[0].([]byte); !ok || !bytes.Equal(b, want[:]) {
+		t.Errorf("QueryArgs(ArgSwappedBinary)[0] = %x, want %x", args[0], want[:])
+	}
+	if b := args[1].([]byte); !bytes.Equal(b, Max[:]) {
+		t.Errorf("QueryArgs(ArgSwappedBinary)[1] = %x, want %x", b, Max[:])
+	}
+
+	if args := QueryArgs(nil, ArgString); len(args) != 0 {
+		t.Errorf("QueryArgs(nil) = %v, want empty", args)
+	}
+}
+
+func TestQueryArgsUnknownFormat(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("QueryArgs(ArgFormat(9)) did not panic")
+		}
+	}()
+	QueryArgs([]UUID{Nil}, ArgFormat(9))
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Array is a slice of UUIDs that scans from and binds as a PostgreSQL
+// uuid[] in the text array format {a,b,c}, for drivers such as lib/pq that
+// do not convert slices themselves:
+//
+//	rows, err := db.Query("SELECT name FROM users WHERE id = ANY($1)", uuid.Array(ids))
+//
+//	var ids uuid.Array
+//	err := row.Scan(&ids)
+//
+// A nil Array is SQL NULL; an empty one is {}.
+type Array []UUID
+
+// Scan implements [database/sql.Scanner]. It accepts a string or []byte in
+// the one-dimensional PostgreSQL array format, with elements in any form
+// [ParseLenient] accepts, optionally double-quoted. SQL NULL scans as a nil
+// Array; NULL elements are rejected.
+func (a *Array) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("uuid: cannot scan %T into Array", src)
+	}
+
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return &ParseError{Input: s, Msg: "expected array literal in braces"}
+	}
+	inner := s[1 : len(s)-1]
+	ids := make(Array, 0, (len(inner)+1)/37)
+	if inner != "" {
+		for elem := range strings.SplitSeq(inner, ",") {
+			if elem == "NULL" {
+				return &ParseError{Input: s, Msg: "NULL element in UUID array"}
+			}
+			u, err := ParseLenient(strings.Trim(elem, `"`))
+			if err != nil {
+				return err
+			}
+			ids = append(ids, u)
+		}
+	}
+	*a = ids
+	return nil
+}
+
+// Value implements [database/sql/driver.Valuer]. It returns the
+// PostgreSQL array literal {a,b,c} as a string, or nil for a nil Array.
+func (a Array) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	b := make([]byte, 0, 2+37*len(a))
+	b = append(b, '{')
+	for i, u := range a {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b, _ = u.AppendText(b)
+	}
+	b = append(b, '}')
+	return string(b), nil
+}
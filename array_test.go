@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestArrayScan(t *testing.T) {
+	a := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b := MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		src  any
+		want Array
+	}{
+		{nil, nil},
+		{"{}", Array{}},
+		{"{" + a.String() + "}", Array{a}},
+		{[]byte("{" + a.String() + "," + b.String() + "}"), Array{a, b}},
+		{`{"` + a.String() + `","{` + b.String() + `}"}`, Array{a, b}},
+	}
+	for _, tt := range tests {
+		var got Array
+		if err := got.Scan(tt.src); err != nil || !slices.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+			t.Errorf("Scan(%v) = %v, %v, want %v", tt.src, got, err, tt.want)
+		}
+	}
+
+	for _, src := range []any{"", "{", a.String(), "{NULL}", "{" + a.String() + ",}", "{bogus}"} {
+		var got Array
+		if _, ok := errors.AsType[*ParseError](got.Scan(src)); !ok {
+			t.Errorf("Scan(%q) did not return a *ParseError", src)
+		}
+	}
+	var got Array
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want error")
+	}
+}
+
+func TestArrayValue(t *testing.T) {
+	a := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b := MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		arr  Array
+		want any
+	}{
+		{nil, nil},
+		{Array{}, "{}"},
+		{Array{a, b}, "{" + a.String() + "," + b.String() + "}"},
+	}
+	for _, tt := range tests {
+		if got, err := tt.arr.Value(); err != nil || got != tt.want {
+			t.Errorf("%v.Value() = %v, %v, want %v", tt.arr, got, err, tt.want)
+		}
+	}
+
+	var back Array
+	v, _ := Array{a, b}.Value()
+	if err := back.Scan(v); err != nil || !slices.Equal(back, Array{a, b}) {
+		t.Errorf("round trip = %v, %v", back, err)
+	}
+}
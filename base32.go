@@ -0,0 +1,115 @@
+package uuid
+
+// crockfordAlphabet is Crockford's base32 alphabet: digits and upper-case
+// letters without I, L, O, and U.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordValues maps characters to their base32 values; 0xff marks
+// invalid. Decoding is case-insensitive and reads I and L as 1 and O as 0,
+// per Crockford's transcription rules.
+var crockfordValues = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := range len(crockfordAlphabet) {
+		c := crockfordAlphabet[i]
+		t[c] = byte(i)
+		t[c|0x20] = byte(i) // lower case; no-op for digits
+	}
+	t['I'], t['i'], t['L'], t['l'] = 1, 1, 1, 1
+	t['O'], t['o'] = 0, 0
+	return t
+}()
+
+// encodeBase32 writes the 26-character Crockford base32 form of u into dst,
+// most significant bits first. The first character encodes only 3 bits.
+func encodeBase32(dst []byte, u UUID) {
+	hi := uint64(u[0])<<56 | uint64(u[1])<<48 | uint64(u[2])<<40 | uint64(u[3])<<32 |
+		uint64(u[4])<<24 | uint64(u[5])<<16 | uint64(u[6])<<8 | uint64(u[7])
+	lo := uint64(u[8])<<56 | uint64(u[9])<<48 | uint64(u[10])<<40 | uint64(u[11])<<32 |
+		uint64(u[12])<<24 | uint64(u[13])<<16 | uint64(u[14])<<8 | uint64(u[15])
+	for i := 25; i >= 0; i-- {
+		dst[i] = crockfordAlphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+}
+
+// decodeBase32 decodes 26 Crockford base32 characters, skipping any byte for
+// which skip returns true. It reports false for invalid characters, a wrong
+// digit count, or a value that overflows 128 bits.
+func decodeBase32(s string, skip func(byte) bool) (UUID, bool) {
+	var hi, lo uint64
+	n := 0
+	for i := range len(s) {
+		c := s[i]
+		if skip != nil && skip(c) {
+			continue
+		}
+		v := crockfordValues[c]
+		if v == 0xff || n == 26 || (n == 0 && v > 7) {
+			return Nil, false
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+		n++
+	}
+	if n != 26 {
+		return Nil, false
+	}
+	var u UUID
+	for i := range 8 {
+		u[i] = byte(hi >> (56 - 8*i))
+		u[8+i] = byte(lo >> (56 - 8*i))
+	}
+	return u, true
+}
+
+// EncodeCrockford returns u as 26 upper-case Crockford base32 characters,
+// the alphabet ULIDs use, such as 01H455VB4PEX5VSKNK084SN02Q. The form
+// preserves byte order, so it sorts like u. [UUID.Display] adds grouping
+// for reading aloud.
+func (u UUID) EncodeCrockford() string {
+	var buf [26]byte
+	encodeBase32(buf[:], u)
+	return string(buf[:])
+}
+
+// ParseCrockford parses the 26-character form produced by
+// [UUID.EncodeCrockford]. It accepts lower case and reads I and L as 1 and
+// O as 0; use [ParseDisplay] if the input may contain hyphens or spaces.
+func ParseCrockford(s string) (UUID, error) {
+	u, ok := decodeBase32(s, nil)
+	if !ok {
+		return Nil, &ParseError{Input: s, Msg: "invalid Crockford base32"}
+	}
+	return u, nil
+}
+
+// Display returns a human-friendly form of u for support tickets, licenses,
+// and other places where people transcribe identifiers: 26 Crockford base32
+// characters in groups, such as 01-H455-VB4P-EX5V-SKNK-084S-N02Q.
+// [ParseDisplay] reads it back, tolerating the usual transcription errors.
+func (u UUID) Display() string {
+	var enc [26]byte
+	encodeBase32(enc[:], u)
+	var buf [32]byte
+	copy(buf[:2], enc[:2])
+	for g := range 6 {
+		buf[2+5*g] = '-'
+		copy(buf[3+5*g:], enc[2+4*g:6+4*g])
+	}
+	return string(buf[:])
+}
+
+// ParseDisplay parses the form produced by [UUID.Display]. It ignores
+// hyphens and spaces wherever they appear, accepts lower case, and reads
+// I and L as 1 and O as 0.
+func ParseDisplay(s string) (UUID, error) {
+	u, ok := decodeBase32(s, func(c byte) bool { return c == '-' || c == ' ' })
+	if !ok {
+		return Nil, &ParseError{Input: s, Msg: "invalid display form"}
+	}
+	return u, nil
+}
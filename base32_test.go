@@ -0,0 +1,89 @@
+package uuid
+
+import "testing"
+
+func TestCrockford(t *testing.T) {
+	tests := []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "00000000000000000000000000"},
+		{Max, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{MustParse("01890a5d-ac96-774b-bcce-b302099a8057"), "01H455VB4PEX5VSKNK084SN02Q"},
+	}
+	for _, tt := range tests {
+		got := tt.uuid.EncodeCrockford()
+		if got != tt.want {
+			t.Errorf("%s.EncodeCrockford() = %q, want %q", tt.uuid, got, tt.want)
+		}
+		back, err := ParseCrockford(got)
+		if err != nil || back != tt.uuid {
+			t.Errorf("ParseCrockford(%q) = %s, %v, want %s", got, back, err, tt.uuid)
+		}
+	}
+
+	want := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	if got, err := ParseCrockford("oih455vb4pex5vsknko84sno2q"); err != nil || got != want {
+		t.Errorf("ParseCrockford(lower case, O/I) = %s, %v, want %s", got, err, want)
+	}
+	for _, s := range []string{
+		"",
+		"01H455VB4PEX5VSKNK084SN02",   // 25 digits
+		"01-H455-VB4P-EX5V-SKNK-084S", // hyphens
+		"81H455VB4PEX5VSKNK084SN02Q",  // overflows 128 bits
+	} {
+		if _, err := ParseCrockford(s); err == nil {
+			t.Errorf("ParseCrockford(%q) should fail", s)
+		}
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	tests := []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "00-0000-0000-0000-0000-0000-0000"},
+		{Max, "7Z-ZZZZ-ZZZZ-ZZZZ-ZZZZ-ZZZZ-ZZZZ"},
+		{MustParse("01890a5d-ac96-774b-bcce-b302099a8057"), "01-H455-VB4P-EX5V-SKNK-084S-N02Q"},
+	}
+	for _, tt := range tests {
+		got := tt.uuid.Display()
+		if got != tt.want {
+			t.Errorf("%s.Display() = %q, want %q", tt.uuid, got, tt.want)
+		}
+		back, err := ParseDisplay(got)
+		if err != nil || back != tt.uuid {
+			t.Errorf("ParseDisplay(%q) = %s, %v, want %s", got, back, err, tt.uuid)
+		}
+	}
+}
+
+func TestParseDisplayTolerant(t *testing.T) {
+	want := MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	for _, s := range []string{
+		"01H455VB4PEX5VSKNK084SN02Q",
+		"01-h455-vb4p-ex5v-sknk-084s-n02q",
+		"01 H455 VB4P EX5V SKNK 084S N02Q",
+		"OI-H455-VB4P-EX5V-SKNK-O84S-NO2Q", // O→0, I→1
+	} {
+		got, err := ParseDisplay(s)
+		if err != nil || got != want {
+			t.Errorf("ParseDisplay(%q) = %s, %v, want %s", s, got, err, want)
+		}
+	}
+}
+
+func TestParseDisplayErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"01-H455-VB4P-EX5V-SKNK-084S-N02",   // 25 digits
+		"01-H455-VB4P-EX5V-SKNK-084S-N02QQ", // 27 digits
+		"81-H455-VB4P-EX5V-SKNK-084S-N02Q",  // overflows 128 bits
+		"01-H455-VB4P-EX5V-SKNK-084S-N02U",  // U is not in the alphabet
+	} {
+		if _, err := ParseDisplay(s); err == nil {
+			t.Errorf("ParseDisplay(%q) should fail", s)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// base58Alphabet is the Bitcoin base58 alphabet: digits and letters without
+// 0, O, I, and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Values maps characters to their base58 values; 0xff marks invalid.
+var base58Values = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := range len(base58Alphabet) {
+		t[base58Alphabet[i]] = byte(i)
+	}
+	return t
+}()
+
+// EncodeBase58 returns u as 22 Bitcoin-alphabet base58 characters, such as
+// EJ34kCVxxF9jHMKD4EgrAK, left-padded with '1' (zero). The alphabet omits
+// the easily confused 0, O, I, and l, and the fixed width keeps the form
+// sorting like u.
+func (u UUID) EncodeBase58() string {
+	hi, lo := binary.BigEndian.Uint64(u[:8]), binary.BigEndian.Uint64(u[8:])
+	var buf [22]byte
+	for i := 21; i >= 0; i-- {
+		var r uint64
+		hi, r = hi/58, hi%58
+		lo, r = bits.Div64(r, lo, 58)
+		buf[i] = base58Alphabet[r]
+	}
+	return string(buf[:])
+}
+
+// ParseBase58 parses the form produced by [UUID.EncodeBase58]. Leading
+// '1' characters may be dropped, so the variable-length output of other
+// base58 encoders of the 16 bytes is accepted too. It returns a
+// [*ParseError] for invalid characters, more than 22 characters, or a
+// value that overflows 128 bits.
+func ParseBase58(s string) (UUID, error) {
+	if len(s) == 0 || len(s) > 22 {
+		return Nil, &ParseError{Input: s, Msg: "invalid base58 length"}
+	}
+	var hi, lo uint64
+	for i := range len(s) {
+		v := base58Values[s[i]]
+		if v == 0xff {
+			return Nil, &ParseError{Input: s, Msg: "invalid base58 character"}
+		}
+		carry, l := bits.Mul64(lo, 58)
+		l, c := bits.Add64(l, uint64(v), 0)
+		over, h := bits.Mul64(hi, 58)
+		h, c2 := bits.Add64(h, carry, c)
+		if over != 0 || c2 != 0 {
+			return Nil, &ParseError{Input: s, Msg: "base58 value overflows 128 bits"}
+		}
+		hi, lo = h, l
+	}
+	var u UUID
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return u, nil
+}
@@ -0,0 +1,42 @@
+package uuid
+
+import "testing"
+
+func TestBase58(t *testing.T) {
+	tests := []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "1111111111111111111111"},
+		{Max, "YcVfxkQb6JRzqk5kF2tNLv"},
+		{MustParse("00000000-0000-0000-0000-000000000001"), "1111111111111111111112"},
+		{MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "EJ34kCVxxF9jHMKD4EgrAK"},
+	}
+	for _, tt := range tests {
+		got := tt.uuid.EncodeBase58()
+		if got != tt.want {
+			t.Errorf("%s.EncodeBase58() = %q, want %q", tt.uuid, got, tt.want)
+		}
+		back, err := ParseBase58(got)
+		if err != nil || back != tt.uuid {
+			t.Errorf("ParseBase58(%q) = %s, %v, want %s", got, back, err, tt.uuid)
+		}
+	}
+	if got, err := ParseBase58("2"); err != nil || got != MustParse("00000000-0000-0000-0000-000000000001") {
+		t.Errorf("ParseBase58(unpadded) = %s, %v", got, err)
+	}
+}
+
+func TestParseBase58Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"11111111111111111111111", // 23 characters
+		"EJ34kCVxxF9jHMKD4EgrA0",  // 0 is not in the alphabet
+		"YcVfxkQb6JRzqk5kF2tNLw",  // Max + 1
+		"zzzzzzzzzzzzzzzzzzzzzz",
+	} {
+		if _, err := ParseBase58(s); err == nil {
+			t.Errorf("ParseBase58(%q) should fail", s)
+		}
+	}
+}
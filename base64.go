@@ -0,0 +1,28 @@
+package uuid
+
+import "encoding/base64"
+
+// base64URL is unpadded RFC 4648 URL-safe base64, rejecting non-zero
+// trailing bits so that each UUID has exactly one encoding.
+var base64URL = base64.RawURLEncoding.Strict()
+
+// EncodeBase64URL returns u as 22 characters of unpadded RFC 4648 URL-safe
+// base64, such as a6e4EJ2tEdGAtADAT9QwyA, for short links and other places
+// where the 36-character form is too long.
+func (u UUID) EncodeBase64URL() string {
+	return base64URL.EncodeToString(u[:])
+}
+
+// ParseBase64URL parses the form produced by [UUID.EncodeBase64URL]. It
+// returns a [*ParseError] unless s is exactly 22 characters of the URL-safe
+// alphabet with no padding.
+func ParseBase64URL(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 22 {
+		return Nil, &ParseError{Input: s, Msg: "expected 22-character base64url"}
+	}
+	if _, err := base64URL.Decode(u[:], []byte(s)); err != nil {
+		return Nil, &ParseError{Input: s, Msg: "invalid base64url"}
+	}
+	return u, nil
+}
@@ -0,0 +1,37 @@
+package uuid
+
+import "testing"
+
+func TestBase64URL(t *testing.T) {
+	tests := []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "AAAAAAAAAAAAAAAAAAAAAA"},
+		{Max, "_____________________w"},
+		{MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "a6e4EJ2tEdGAtADAT9QwyA"},
+	}
+	for _, tt := range tests {
+		got := tt.uuid.EncodeBase64URL()
+		if got != tt.want {
+			t.Errorf("%s.EncodeBase64URL() = %q, want %q", tt.uuid, got, tt.want)
+		}
+		back, err := ParseBase64URL(got)
+		if err != nil || back != tt.uuid {
+			t.Errorf("ParseBase64URL(%q) = %s, %v, want %s", got, back, err, tt.uuid)
+		}
+	}
+}
+
+func TestParseBase64URLErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"a6e4EJ2tEdGAtADAT9QwyA==", // padded
+		"a6e4EJ2tEdGAtADAT9QwyB",   // non-zero trailing bits
+		"a6e4EJ2tEdGAtADAT9Qwy+",   // standard, not URL-safe, alphabet
+	} {
+		if _, err := ParseBase64URL(s); err == nil {
+			t.Errorf("ParseBase64URL(%q) should fail", s)
+		}
+	}
+}
@@ -5,7 +5,5 @@ go 1.26.0
 require (
 	github.com/gofrs/uuid/v5 v5.3.2
 	github.com/google/uuid v1.6.0
-	github.com/pscheid92/uuid v0.0.0
+	github.com/pscheid92/uuid v0.2.0
 )
-
-replace github.com/pscheid92/uuid => ..
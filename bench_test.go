@@ -20,6 +20,28 @@ func BenchmarkNewV4Batch100(b *testing.B) {
 	}
 }
 
+func BenchmarkNewV5CustomNamespace(b *testing.B) {
+	ns := MustParse("0f0e0d0c-0b0a-4908-8706-050403020100")
+	for b.Loop() {
+		NewV5(ns, "order-42")
+	}
+}
+
+func BenchmarkNameHasher(b *testing.B) {
+	h := NewV5Hasher(MustParse("0f0e0d0c-0b0a-4908-8706-050403020100"))
+	for b.Loop() {
+		h.Sum("order-42")
+	}
+}
+
+func BenchmarkFillV4(b *testing.B) {
+	dst := make([]UUID, 100)
+	b.ReportAllocs()
+	for b.Loop() {
+		FillV4(dst)
+	}
+}
+
 func BenchmarkNewV4Pool(b *testing.B) {
 	pool := NewPool()
 	for b.Loop() {
@@ -41,6 +63,13 @@ func BenchmarkNewV7(b *testing.B) {
 	}
 }
 
+func BenchmarkNewV7String(b *testing.B) {
+	gen := NewGenerator()
+	for b.Loop() {
+		gen.NewV7String()
+	}
+}
+
 func BenchmarkNewV7Batch100(b *testing.B) {
 	gen := NewGenerator()
 	for b.Loop() {
@@ -48,6 +77,15 @@ func BenchmarkNewV7Batch100(b *testing.B) {
 	}
 }
 
+func BenchmarkFillV7(b *testing.B) {
+	gen := NewGenerator()
+	dst := make([]UUID, 100)
+	b.ReportAllocs()
+	for b.Loop() {
+		gen.FillV7(dst)
+	}
+}
+
 func BenchmarkNewV8(b *testing.B) {
 	var data [16]byte
 	for b.Loop() {
@@ -92,6 +130,14 @@ func BenchmarkAppendText(b *testing.B) {
 	}
 }
 
+func BenchmarkAppendJSON(b *testing.B) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	buf := make([]byte, 0, 64)
+	for b.Loop() {
+		buf = u.AppendJSON(buf[:0])
+	}
+}
+
 func BenchmarkMarshalText(b *testing.B) {
 	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	for b.Loop() {
@@ -99,6 +145,15 @@ func BenchmarkMarshalText(b *testing.B) {
 	}
 }
 
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	data := []byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`)
+	var u UUID
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = u.UnmarshalJSON(data)
+	}
+}
+
 func BenchmarkMarshalBinary(b *testing.B) {
 	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	for b.Loop() {
@@ -113,6 +168,20 @@ func BenchmarkFromBytes(b *testing.B) {
 	}
 }
 
+func BenchmarkDecodeAll(b *testing.B) {
+	data := EncodeAll(NewV4Batch(1000))
+	for b.Loop() {
+		_, _ = DecodeAll(data)
+	}
+}
+
+func BenchmarkQueryArgs(b *testing.B) {
+	ids := NewV4Batch(1000)
+	for b.Loop() {
+		_ = QueryArgs(ids, ArgString)
+	}
+}
+
 func BenchmarkCompare(b *testing.B) {
 	a := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	c := MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
@@ -120,3 +189,10 @@ func BenchmarkCompare(b *testing.B) {
 		Compare(a, c)
 	}
 }
+
+func BenchmarkEncodeBase58(b *testing.B) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	for b.Loop() {
+		_ = u.EncodeBase58()
+	}
+}
@@ -0,0 +1,63 @@
+package uuid
+
+import "database/sql/driver"
+
+// BinaryUUID is a UUID stored as its raw 16 bytes, for MySQL and MariaDB
+// BINARY(16) columns, where the 36-character string form would more than
+// double the index size. Its Value returns the raw bytes; Scan accepts raw
+// bytes and every text form [UUID.Scan] does. It encodes as text like
+// [UUID] everywhere else:
+//
+//	type Row struct {
+//	    ID uuid.BinaryUUID `db:"id"`
+//	}
+type BinaryUUID UUID
+
+// UUID returns b as a plain [UUID].
+func (b BinaryUUID) UUID() UUID {
+	return UUID(b)
+}
+
+// String returns the standard 36-character hyphenated representation.
+func (b BinaryUUID) String() string {
+	return UUID(b).String()
+}
+
+// MarshalText returns the 36-character hyphenated representation.
+// It implements [encoding.TextMarshaler].
+func (b BinaryUUID) MarshalText() ([]byte, error) {
+	return UUID(b).MarshalText()
+}
+
+// UnmarshalText parses a UUID from text (strict 36-char format).
+// It implements [encoding.TextUnmarshaler].
+func (b *BinaryUUID) UnmarshalText(data []byte) error {
+	return (*UUID)(b).UnmarshalText(data)
+}
+
+// UnmarshalJSON parses a JSON string in the strict 36-character form; only
+// the database encoding of BinaryUUID is binary. null decodes to [Nil].
+// It implements [encoding/json.Unmarshaler].
+func (b *BinaryUUID) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonText(data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		*b = BinaryUUID(Nil)
+		return nil
+	}
+	return b.UnmarshalText(s)
+}
+
+// Scan implements [database/sql.Scanner]. Like [UUID.Scan], it accepts 16
+// raw bytes or any text form.
+func (b *BinaryUUID) Scan(src any) error {
+	return (*UUID)(b).Scan(src)
+}
+
+// Value implements [database/sql/driver.Valuer].
+// It returns the raw 16 bytes.
+func (b BinaryUUID) Value() (driver.Value, error) {
+	return b[:], nil
+}
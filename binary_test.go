@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestBinaryUUIDScanValue(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b := BinaryUUID(u)
+
+	v, err := b.Value()
+	if raw, ok := v.([]byte); err != nil || !ok || !bytes.Equal(raw, u[:]) {
+		t.Errorf("Value() = %#v, %v, want the 16 raw bytes", v, err)
+	}
+	for _, src := range []any{u[:], u.String(), []byte(u.URN())} {
+		var got BinaryUUID
+		if err := got.Scan(src); err != nil || got.UUID() != u {
+			t.Errorf("Scan(%v) = %s, %v, want %s", src, got, err, u)
+		}
+	}
+	var got BinaryUUID
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want error")
+	}
+}
+
+func TestBinaryUUIDJSON(t *testing.T) {
+	type row struct {
+		ID BinaryUUID `json:"id"`
+	}
+	in := `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`
+	var r row
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.ID.String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("json.Unmarshal() = %s", r.ID)
+	}
+	out, err := json.Marshal(r)
+	if err != nil || string(out) != in {
+		t.Errorf("json.Marshal() = %s, %v, want %s", out, err, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":null}`), &r); err != nil || r.ID.UUID() != Nil {
+		t.Errorf("json.Unmarshal(null) = %s, %v, want Nil", r.ID, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":42}`), &r); err == nil {
+		t.Error("json.Unmarshal(42) succeeded, want error")
+	}
+}
@@ -0,0 +1,58 @@
+package uuid
+
+import "time"
+
+// TruncateTime returns the bucket key of a V7 UUID: the V7 UUID whose
+// timestamp is u's rounded down to a multiple of d since the Unix epoch
+// (so hourly and daily buckets align with UTC), with rand_a and rand_b
+// zeroed. All UUIDs created within the same bucket share the key, which is
+// also the lowest V7 UUID of the bucket, making it usable as a partition
+// key or range-scan prefix. Values of d below one millisecond truncate to
+// the millisecond. It returns Nil for non-V7 UUIDs.
+func (u UUID) TruncateTime(d time.Duration) UUID {
+	if u.Version() != V7 {
+		return Nil
+	}
+	return bucketKey(u.Time().UnixMilli(), d)
+}
+
+// BucketOf returns the bucket key that [UUID.TruncateTime] yields for V7
+// UUIDs created at t. For times before the Unix epoch it returns the key of
+// the epoch.
+func BucketOf(t time.Time, d time.Duration) UUID {
+	return bucketKey(max(t.UnixMilli(), 0), d)
+}
+
+// V7Min returns the smallest V7 UUID for the millisecond of t: its timestamp
+// with rand_a and rand_b zeroed. Together with [V7Max] it turns time ranges
+// into range scans over V7 primary keys:
+//
+//	WHERE id >= $1 AND id < $2 -- uuid.V7Min(start), uuid.V7Min(end)
+//
+// For times before the Unix epoch it returns the minimum of the epoch.
+func V7Min(t time.Time) UUID {
+	return bucketKey(max(t.UnixMilli(), 0), time.Millisecond)
+}
+
+// V7Max returns the largest V7 UUID for the millisecond of t: its timestamp
+// with every rand_a and rand_b bit set. For times before the Unix epoch it
+// returns the maximum of the epoch.
+func V7Max(t time.Time) UUID {
+	u := V7Min(t)
+	u[6] |= 0x0f
+	u[7] = 0xff
+	u[8] = 0xbf // variant RFC 9562
+	for i := 9; i < 16; i++ {
+		u[i] = 0xff
+	}
+	return u
+}
+
+// bucketKey returns the V7 UUID for ms truncated to a multiple of d, with
+// zero randomness.
+func bucketKey(ms int64, d time.Duration) UUID {
+	ms -= ms % max(int64(d/time.Millisecond), 1)
+	var u UUID
+	putV7(&u, ms<<12)
+	return u
+}
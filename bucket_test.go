@@ -0,0 +1,71 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTruncateTime(t *testing.T) {
+	// 2024-05-17T13:25:37.595Z
+	id := MustParse("018f86ba-c2bb-7abc-9123-456789abcdef")
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "018f86ba-c2bb-7000-8000-000000000000"},
+		{time.Microsecond, "018f86ba-c2bb-7000-8000-000000000000"},
+		{time.Second, "018f86ba-c068-7000-8000-000000000000"},
+		{time.Hour, "018f86a3-4c80-7000-8000-000000000000"},
+		{24 * time.Hour, "018f83d9-3000-7000-8000-000000000000"},
+	}
+	for _, tt := range tests {
+		got := id.TruncateTime(tt.d)
+		if got.String() != tt.want {
+			t.Errorf("TruncateTime(%v) = %s, want %s", tt.d, got, tt.want)
+		}
+		if tt.d >= time.Millisecond && !got.Time().Equal(id.Time().Truncate(tt.d)) {
+			t.Errorf("TruncateTime(%v).Time() = %v, want %v", tt.d, got.Time(), id.Time().Truncate(tt.d))
+		}
+		if b := BucketOf(id.Time(), tt.d); b != got {
+			t.Errorf("BucketOf(%v) = %s, want %s", tt.d, b, got)
+		}
+	}
+	if got := NewV4().TruncateTime(time.Hour); got != Nil {
+		t.Errorf("TruncateTime(V4) = %s, want Nil", got)
+	}
+}
+
+func TestV7MinMax(t *testing.T) {
+	ts := time.Date(2024, 5, 17, 13, 25, 37, 595_900_000, time.UTC)
+	lo, hi := V7Min(ts), V7Max(ts)
+	if want := "018f86ba-c2bb-7000-8000-000000000000"; lo.String() != want {
+		t.Errorf("V7Min() = %s, want %s", lo, want)
+	}
+	if want := "018f86ba-c2bb-7fff-bfff-ffffffffffff"; hi.String() != want {
+		t.Errorf("V7Max() = %s, want %s", hi, want)
+	}
+	if hi.Version() != V7 || hi.Variant() != VariantRFC9562 {
+		t.Errorf("V7Max() = %s, want an RFC 9562 V7 UUID", hi)
+	}
+	id := MustParse("018f86ba-c2bb-7abc-9123-456789abcdef")
+	if Compare(lo, id) > 0 || Compare(id, hi) > 0 || Compare(hi, V7Min(ts.Add(time.Millisecond))) >= 0 {
+		t.Errorf("%s not within [%s, %s] below the next millisecond", id, lo, hi)
+	}
+	if got := V7Max(time.Unix(-1, 0)); got.Time().UnixMilli() != 0 {
+		t.Errorf("V7Max(before epoch) = %s, want the epoch", got)
+	}
+}
+
+func TestBucketOf(t *testing.T) {
+	ts := time.Date(2024, 5, 17, 13, 0, 0, 0, time.UTC)
+	first, last := BucketOf(ts, time.Hour), BucketOf(ts.Add(time.Hour-time.Nanosecond), time.Hour)
+	if first != last {
+		t.Errorf("BucketOf() differs within one hour: %s, %s", first, last)
+	}
+	if next := BucketOf(ts.Add(time.Hour), time.Hour); Compare(next, first) <= 0 {
+		t.Errorf("BucketOf(next hour) = %s, want > %s", next, first)
+	}
+	if got, want := BucketOf(time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), time.Hour), BucketOf(time.Unix(0, 0), time.Hour); got != want {
+		t.Errorf("BucketOf(1960) = %s, want %s", got, want)
+	}
+}
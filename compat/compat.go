@@ -0,0 +1,57 @@
+// Package compat converts between this module's UUID and the types of
+// github.com/google/uuid and github.com/gofrs/uuid, for codebases that
+// migrate incrementally.
+//
+// All three types are [16]byte arrays, so every conversion is a plain copy:
+//
+//	id := compat.FromGoogle(googleID)
+//	row.ID = compat.ToGofrs(id)
+package compat
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/pscheid92/uuid"
+)
+
+// FromGoogle converts a google/uuid UUID.
+func FromGoogle(u google.UUID) uuid.UUID { return uuid.UUID(u) }
+
+// ToGoogle converts to a google/uuid UUID.
+func ToGoogle(u uuid.UUID) google.UUID { return google.UUID(u) }
+
+// FromGofrs converts a gofrs/uuid UUID.
+func FromGofrs(u gofrs.UUID) uuid.UUID { return uuid.UUID(u) }
+
+// ToGofrs converts to a gofrs/uuid UUID.
+func ToGofrs(u uuid.UUID) gofrs.UUID { return gofrs.UUID(u) }
+
+// Scanner returns a [sql.Scanner] that decodes a column with
+// [uuid.UUID.Scan] and stores the result in dst. Use it to read into
+// google/uuid or gofrs/uuid fields with this package's parsing rules:
+//
+//	var id google.UUID
+//	err := row.Scan(compat.Scanner(&id))
+func Scanner[T ~[16]byte](dst *T) sql.Scanner {
+	return scanner[T]{dst}
+}
+
+type scanner[T ~[16]byte] struct{ dst *T }
+
+func (s scanner[T]) Scan(src any) error {
+	var u uuid.UUID
+	if err := u.Scan(src); err != nil {
+		return err
+	}
+	*s.dst = T(u)
+	return nil
+}
+
+// Valuer returns a [driver.Valuer] that encodes id like [uuid.UUID.Value],
+// so foreign UUID types are written in the same representation.
+func Valuer[T ~[16]byte](id T) driver.Valuer {
+	return uuid.UUID(id)
+}
@@ -0,0 +1,68 @@
+package compat
+
+import (
+	"testing"
+
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/pscheid92/uuid"
+)
+
+const testUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+func TestConversions(t *testing.T) {
+	id := uuid.MustParse(testUUID)
+
+	g := ToGoogle(id)
+	if g.String() != testUUID {
+		t.Errorf("ToGoogle() = %s, want %s", g, testUUID)
+	}
+	if FromGoogle(g) != id {
+		t.Errorf("FromGoogle() = %s, want %s", FromGoogle(g), id)
+	}
+
+	f := ToGofrs(id)
+	if f.String() != testUUID {
+		t.Errorf("ToGofrs() = %s, want %s", f, testUUID)
+	}
+	if FromGofrs(f) != id {
+		t.Errorf("FromGofrs() = %s, want %s", FromGofrs(f), id)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	var g google.UUID
+	if err := Scanner(&g).Scan("{" + testUUID + "}"); err != nil {
+		t.Fatalf("Scan(braced) error: %v", err)
+	}
+	if g.String() != testUUID {
+		t.Errorf("google = %s, want %s", g, testUUID)
+	}
+
+	var f gofrs.UUID
+	raw := uuid.MustParse(testUUID)
+	if err := Scanner(&f).Scan(raw[:]); err != nil {
+		t.Fatalf("Scan(bytes) error: %v", err)
+	}
+	if f.String() != testUUID {
+		t.Errorf("gofrs = %s, want %s", f, testUUID)
+	}
+
+	before := f
+	if err := Scanner(&f).Scan(42); err == nil {
+		t.Error("Scan(int) should fail")
+	}
+	if f != before {
+		t.Errorf("failed Scan modified dst: %s", f)
+	}
+}
+
+func TestValuer(t *testing.T) {
+	v, err := Valuer(google.MustParse(testUUID)).Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != testUUID {
+		t.Errorf("Value() = %v, want %s", v, testUUID)
+	}
+}
@@ -0,0 +1,9 @@
+module github.com/pscheid92/uuid/compat
+
+go 1.26.0
+
+require (
+	github.com/gofrs/uuid/v5 v5.3.2
+	github.com/google/uuid v1.6.0
+	github.com/pscheid92/uuid v0.2.0 // NewV4FromReader is unreleased; bump with the next root tag
+)
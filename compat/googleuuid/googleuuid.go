@@ -0,0 +1,139 @@
+// Package uuid mirrors the API of github.com/google/uuid on top of
+// github.com/pscheid92/uuid, so code can migrate by changing only the
+// import path:
+//
+//	import "github.com/pscheid92/uuid/compat/googleuuid"
+//
+// [UUID], [Version], and [Variant] are aliases of the underlying package's
+// types, so values flow freely between old and modernized code. Where the
+// two libraries differ, the underlying methods win: [UUID.Time] returns a
+// [time.Time], and V1/V3 generation, node IDs, and clock sequences are not
+// provided.
+package uuid
+
+import (
+	"io"
+	"sync/atomic"
+
+	pscheid "github.com/pscheid92/uuid"
+)
+
+// UUID is an alias of the underlying UUID type.
+type UUID = pscheid.UUID
+
+// Version is an alias of the underlying Version type.
+type Version = pscheid.Version
+
+// Variant is an alias of the underlying Variant type.
+type Variant = pscheid.Variant
+
+// Variant constants under their google/uuid names. google/uuid's Invalid
+// variant has no equivalent.
+const (
+	RFC4122   = pscheid.VariantRFC9562
+	Reserved  = pscheid.VariantNCS
+	Microsoft = pscheid.VariantMicrosoft
+	Future    = pscheid.VariantFuture
+)
+
+// Well-known UUIDs under their google/uuid names.
+var (
+	Nil = pscheid.Nil
+	Max = pscheid.Max
+
+	NameSpaceDNS  = pscheid.NamespaceDNS
+	NameSpaceURL  = pscheid.NamespaceURL
+	NameSpaceOID  = pscheid.NamespaceOID
+	NameSpaceX500 = pscheid.NamespaceX500
+)
+
+// UUIDs is a slice of UUIDs.
+type UUIDs []UUID
+
+// Strings returns the string form of each UUID.
+func (uuids UUIDs) Strings() []string {
+	s := make([]string, len(uuids))
+	for i, u := range uuids {
+		s[i] = u.String()
+	}
+	return s
+}
+
+var (
+	poolEnabled atomic.Bool
+	pool        = pscheid.NewPool()
+)
+
+// EnableRandPool makes [New] and [NewRandom] draw from a shared
+// [pscheid.Pool] that amortizes crypto/rand reads.
+func EnableRandPool() { poolEnabled.Store(true) }
+
+// DisableRandPool reverts [EnableRandPool].
+func DisableRandPool() { poolEnabled.Store(false) }
+
+// New returns a random (Version 4) UUID.
+func New() UUID {
+	if poolEnabled.Load() {
+		return pool.NewV4()
+	}
+	return pscheid.NewV4()
+}
+
+// NewString returns the string form of [New].
+func NewString() string {
+	return New().String()
+}
+
+// NewRandom returns a random (Version 4) UUID. The error is always nil.
+func NewRandom() (UUID, error) {
+	return New(), nil
+}
+
+// NewRandomFromReader returns a random (Version 4) UUID read from r.
+func NewRandomFromReader(r io.Reader) (UUID, error) {
+	return pscheid.NewV4FromReader(r)
+}
+
+// NewV7 returns a Version 7 UUID. The error is always nil.
+func NewV7() (UUID, error) {
+	return pscheid.NewV7(), nil
+}
+
+// NewSHA1 returns a Version 5 UUID for data in space.
+func NewSHA1(space UUID, data []byte) UUID {
+	return pscheid.NewV5(space, string(data))
+}
+
+// Parse decodes s in the standard, URN, braced, or compact form.
+func Parse(s string) (UUID, error) {
+	return pscheid.ParseLenient(s)
+}
+
+// ParseBytes is like [Parse] but takes a byte slice.
+func ParseBytes(b []byte) (UUID, error) {
+	return pscheid.ParseLenient(string(b))
+}
+
+// MustParse is like [Parse] but panics on error.
+func MustParse(s string) UUID {
+	return Must(Parse(s))
+}
+
+// Must returns uuid or panics if err is not nil.
+func Must(uuid UUID, err error) UUID {
+	if err != nil {
+		panic(err)
+	}
+	return uuid
+}
+
+// FromBytes creates a UUID from a 16-byte slice.
+func FromBytes(b []byte) (UUID, error) {
+	return pscheid.FromBytes(b)
+}
+
+// Validate reports whether s is a UUID in any form accepted by [Parse].
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+
+	pscheid "github.com/pscheid92/uuid"
+)
+
+const testUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+func TestGenerate(t *testing.T) {
+	if v := New().Version(); v != 4 {
+		t.Errorf("New().Version() = %d, want 4", v)
+	}
+	if s := NewString(); len(s) != 36 {
+		t.Errorf("NewString() = %q", s)
+	}
+	if u, err := NewRandom(); err != nil || u.Version() != 4 {
+		t.Errorf("NewRandom() = %s, %v", u, err)
+	}
+	if u, err := NewRandomFromReader(strings.NewReader("0123456789abcdef")); err != nil || u.String() != "30313233-3435-4637-b839-616263646566" {
+		t.Errorf("NewRandomFromReader() = %s, %v", u, err)
+	}
+	if u, err := NewV7(); err != nil || u.Version() != 7 {
+		t.Errorf("NewV7() = %s, %v", u, err)
+	}
+	// Known vector from google/uuid.
+	if got := NewSHA1(NameSpaceDNS, []byte("python.org")).String(); got != "886313e1-3b8a-5372-9b90-0c9aee199e5d" {
+		t.Errorf("NewSHA1() = %s", got)
+	}
+}
+
+func TestRandPool(t *testing.T) {
+	EnableRandPool()
+	defer DisableRandPool()
+	a, b := New(), New()
+	if a == b || a.Version() != 4 {
+		t.Errorf("pooled New() = %s, %s", a, b)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{testUUID, "urn:uuid:" + testUUID, "{" + testUUID + "}", "6ba7b8109dad11d180b400c04fd430c8"} {
+		if u, err := Parse(s); err != nil || u.String() != testUUID {
+			t.Errorf("Parse(%q) = %s, %v", s, u, err)
+		}
+		if u, err := ParseBytes([]byte(s)); err != nil || u.String() != testUUID {
+			t.Errorf("ParseBytes(%q) = %s, %v", s, u, err)
+		}
+	}
+	if err := Validate("bogus"); err == nil {
+		t.Error("Validate(bogus) should fail")
+	}
+	if _, err := FromBytes([]byte{1}); err == nil {
+		t.Error("FromBytes(short) should fail")
+	}
+	if MustParse(testUUID) != pscheid.MustParse(testUUID) {
+		t.Error("MustParse mismatch")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse(bogus) should panic")
+		}
+	}()
+	MustParse("bogus")
+}
+
+func TestAliases(t *testing.T) {
+	var id pscheid.UUID = MustParse(testUUID) // no conversion needed
+	if id != NameSpaceDNS {
+		t.Errorf("id = %s", id)
+	}
+	if id.Variant() != RFC4122 {
+		t.Errorf("Variant() = %v, want RFC4122", id.Variant())
+	}
+	if got := (UUIDs{Nil, Max}).Strings(); got[0] != Nil.String() || got[1] != Max.String() {
+		t.Errorf("Strings() = %v", got)
+	}
+}
@@ -0,0 +1,94 @@
+package uuid
+
+import (
+	"bytes"
+	"database/sql/driver"
+
+	pscheid "github.com/pscheid92/uuid"
+)
+
+// NullUUID represents a UUID that may be null, with the same SQL and JSON
+// behavior as google/uuid's NullUUID.
+type NullUUID struct {
+	UUID  UUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// Scan implements [database/sql.Scanner]. A nil src sets Valid to false.
+func (nu *NullUUID) Scan(value any) error {
+	if value == nil {
+		nu.UUID, nu.Valid = Nil, false
+		return nil
+	}
+	err := nu.UUID.Scan(value)
+	nu.Valid = err == nil
+	return err
+}
+
+// Value implements [database/sql/driver.Valuer].
+func (nu NullUUID) Value() (driver.Value, error) {
+	if !nu.Valid {
+		return nil, nil
+	}
+	return nu.UUID.Value()
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+func (nu NullUUID) MarshalBinary() ([]byte, error) {
+	if nu.Valid {
+		return nu.UUID[:], nil
+	}
+	return nil, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (nu *NullUUID) UnmarshalBinary(data []byte) error {
+	if err := nu.UUID.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	nu.Valid = true
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (nu NullUUID) MarshalText() ([]byte, error) {
+	if nu.Valid {
+		return nu.UUID.MarshalText()
+	}
+	return jsonNull, nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (nu *NullUUID) UnmarshalText(data []byte) error {
+	id, err := ParseBytes(data)
+	if err != nil {
+		nu.Valid = false
+		return err
+	}
+	nu.UUID, nu.Valid = id, true
+	return nil
+}
+
+var jsonNull = []byte("null")
+
+// MarshalJSON implements [encoding/json.Marshaler]. An invalid NullUUID
+// encodes as null.
+func (nu NullUUID) MarshalJSON() ([]byte, error) {
+	if nu.Valid {
+		return []byte(`"` + nu.UUID.String() + `"`), nil
+	}
+	return jsonNull, nil
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler]. A JSON null sets
+// Valid to false.
+func (nu *NullUUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*nu = NullUUID{}
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &pscheid.ParseError{Input: string(data), Msg: "expected JSON string"}
+	}
+	return nu.UnmarshalText(data[1 : len(data)-1])
+}
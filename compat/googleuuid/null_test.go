@@ -0,0 +1,83 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullUUIDScanValue(t *testing.T) {
+	var nu NullUUID
+	if err := nu.Scan(testUUID); err != nil || !nu.Valid || nu.UUID.String() != testUUID {
+		t.Fatalf("Scan() = %+v, %v", nu, err)
+	}
+	if v, err := nu.Value(); err != nil || v != testUUID {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if err := nu.Scan(nil); err != nil || nu.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", nu, err)
+	}
+	if v, err := nu.Value(); err != nil || v != nil {
+		t.Errorf("Value() = %v, %v, want nil", v, err)
+	}
+	if err := nu.Scan(42); err == nil || nu.Valid {
+		t.Errorf("Scan(int) = %+v, %v", nu, err)
+	}
+}
+
+func TestNullUUIDJSON(t *testing.T) {
+	type doc struct {
+		ID NullUUID `json:"id"`
+	}
+	b, err := json.Marshal(doc{})
+	if err != nil || string(b) != `{"id":null}` {
+		t.Errorf("Marshal(invalid) = %s, %v", b, err)
+	}
+	b, err = json.Marshal(doc{NullUUID{MustParse(testUUID), true}})
+	if err != nil || string(b) != `{"id":"`+testUUID+`"}` {
+		t.Errorf("Marshal(valid) = %s, %v", b, err)
+	}
+
+	var d doc
+	if err := json.Unmarshal(b, &d); err != nil || !d.ID.Valid || d.ID.UUID.String() != testUUID {
+		t.Errorf("Unmarshal(valid) = %+v, %v", d, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":null}`), &d); err != nil || d.ID.Valid {
+		t.Errorf("Unmarshal(null) = %+v, %v", d, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"bogus"}`), &d); err == nil {
+		t.Error("Unmarshal(bogus) should fail")
+	}
+	if err := d.ID.UnmarshalJSON([]byte(`42`)); err == nil {
+		t.Error("UnmarshalJSON(42) should fail")
+	}
+}
+
+func TestNullUUIDText(t *testing.T) {
+	var nu NullUUID
+	if b, err := nu.MarshalText(); err != nil || string(b) != "null" {
+		t.Errorf("MarshalText(invalid) = %s, %v", b, err)
+	}
+	if err := nu.UnmarshalText([]byte(testUUID)); err != nil || !nu.Valid {
+		t.Fatalf("UnmarshalText() = %+v, %v", nu, err)
+	}
+	if b, err := nu.MarshalText(); err != nil || string(b) != testUUID {
+		t.Errorf("MarshalText() = %s, %v", b, err)
+	}
+}
+
+func TestNullUUIDBinary(t *testing.T) {
+	var nu NullUUID
+	if b, err := nu.MarshalBinary(); err != nil || b != nil {
+		t.Errorf("MarshalBinary(invalid) = %v, %v", b, err)
+	}
+	id := MustParse(testUUID)
+	if err := nu.UnmarshalBinary(id[:]); err != nil || !nu.Valid || nu.UUID != id {
+		t.Fatalf("UnmarshalBinary() = %+v, %v", nu, err)
+	}
+	if b, err := nu.MarshalBinary(); err != nil || string(b) != string(id[:]) {
+		t.Errorf("MarshalBinary() = %v, %v", b, err)
+	}
+	if err := nu.UnmarshalBinary([]byte{1}); err == nil {
+		t.Error("UnmarshalBinary(short) should fail")
+	}
+}
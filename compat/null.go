@@ -0,0 +1,49 @@
+package compat
+
+import (
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/pscheid92/uuid"
+)
+
+// This package represents a nullable UUID as *uuid.UUID: nil is SQL NULL
+// and encodes as JSON null, matching an invalid google/uuid or gofrs/uuid
+// NullUUID.
+
+// FromGoogleNull converts a google/uuid NullUUID, returning nil if it is
+// not valid.
+func FromGoogleNull(n google.NullUUID) *uuid.UUID {
+	if !n.Valid {
+		return nil
+	}
+	u := uuid.UUID(n.UUID)
+	return &u
+}
+
+// ToGoogleNull converts to a google/uuid NullUUID, which is valid if u is
+// not nil.
+func ToGoogleNull(u *uuid.UUID) google.NullUUID {
+	if u == nil {
+		return google.NullUUID{}
+	}
+	return google.NullUUID{UUID: google.UUID(*u), Valid: true}
+}
+
+// FromGofrsNull converts a gofrs/uuid NullUUID, returning nil if it is not
+// valid.
+func FromGofrsNull(n gofrs.NullUUID) *uuid.UUID {
+	if !n.Valid {
+		return nil
+	}
+	u := uuid.UUID(n.UUID)
+	return &u
+}
+
+// ToGofrsNull converts to a gofrs/uuid NullUUID, which is valid if u is not
+// nil.
+func ToGofrsNull(u *uuid.UUID) gofrs.NullUUID {
+	if u == nil {
+		return gofrs.NullUUID{}
+	}
+	return gofrs.NullUUID{UUID: gofrs.UUID(*u), Valid: true}
+}
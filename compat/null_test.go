@@ -0,0 +1,65 @@
+package compat
+
+import (
+	"encoding/json"
+	"testing"
+
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/pscheid92/uuid"
+)
+
+func TestGoogleNull(t *testing.T) {
+	if FromGoogleNull(google.NullUUID{}) != nil {
+		t.Error("FromGoogleNull(invalid) should be nil")
+	}
+	if ToGoogleNull(nil).Valid {
+		t.Error("ToGoogleNull(nil) should be invalid")
+	}
+	id := uuid.MustParse(testUUID)
+	n := ToGoogleNull(&id)
+	if !n.Valid || n.UUID.String() != testUUID {
+		t.Errorf("ToGoogleNull() = %+v", n)
+	}
+	if p := FromGoogleNull(n); p == nil || *p != id {
+		t.Errorf("FromGoogleNull() = %v, want %s", p, id)
+	}
+}
+
+func TestGofrsNull(t *testing.T) {
+	if FromGofrsNull(gofrs.NullUUID{}) != nil {
+		t.Error("FromGofrsNull(invalid) should be nil")
+	}
+	if ToGofrsNull(nil).Valid {
+		t.Error("ToGofrsNull(nil) should be invalid")
+	}
+	id := uuid.MustParse(testUUID)
+	n := ToGofrsNull(&id)
+	if !n.Valid || n.UUID.String() != testUUID {
+		t.Errorf("ToGofrsNull() = %+v", n)
+	}
+	if p := FromGofrsNull(n); p == nil || *p != id {
+		t.Errorf("FromGofrsNull() = %v, want %s", p, id)
+	}
+}
+
+// TestNullJSON checks that a converted field encodes identically, so
+// swapping the type of a public struct field does not change its JSON.
+func TestNullJSON(t *testing.T) {
+	id := uuid.MustParse(testUUID)
+	for _, p := range []*uuid.UUID{nil, &id} {
+		want, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("json.Marshal(*UUID) error: %v", err)
+		}
+		for _, foreign := range []any{ToGoogleNull(p), ToGofrsNull(p)} {
+			got, err := json.Marshal(foreign)
+			if err != nil {
+				t.Fatalf("json.Marshal(%T) error: %v", foreign, err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("json.Marshal(%T) = %s, want %s", foreign, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadCSVColumn reads CSV records from r and parses field col (0-based) of
+// each with [ParseLenient]. Records may have differing numbers of fields.
+// Errors name the input line; parse failures wrap a [*ParseError]. Every
+// record is parsed, so skip a header row before calling.
+func ReadCSVColumn(r io.Reader, col int) ([]UUID, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	var ids []UUID
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return ids, nil
+		}
+		if err != nil {
+			return ids, err
+		}
+		if col < 0 || col >= len(record) {
+			line, _ := cr.FieldPos(0)
+			return ids, fmt.Errorf("uuid: csv line %d: no column %d in record with %d fields", line, col, len(record))
+		}
+		u, err := ParseLenient(record[col])
+		if err != nil {
+			line, _ := cr.FieldPos(col)
+			return ids, fmt.Errorf("uuid: csv line %d: %w", line, err)
+		}
+		ids = append(ids, u)
+	}
+}
+
+// WriteCSVColumn writes ids to w as a single-column CSV, one canonical
+// 36-character UUID per line. Canonical UUIDs never need quoting, so the
+// output is also plain newline-separated text.
+func WriteCSVColumn(w io.Writer, ids []UUID) error {
+	bw := bufio.NewWriter(w)
+	var line [37]byte
+	line[36] = '\n'
+	for _, u := range ids {
+		encodeHex(line[:], u)
+		if _, err := bw.Write(line[:]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
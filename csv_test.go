@@ -0,0 +1,95 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadCSVColumn(t *testing.T) {
+	in := "alice,01890a5d-ac96-774b-bcce-b302099a8057\n" +
+		"bob,\"{01890A5D-AC96-774B-BCCE-B302099A8058}\",extra\n" +
+		"carol,urn:uuid:01890a5d-ac96-774b-bcce-b302099a8059\n"
+	ids, err := ReadCSVColumn(strings.NewReader(in), 1)
+	if err != nil {
+		t.Fatalf("ReadCSVColumn() error: %v", err)
+	}
+	want := []UUID{
+		MustParse("01890a5d-ac96-774b-bcce-b302099a8057"),
+		MustParse("01890a5d-ac96-774b-bcce-b302099a8058"),
+		MustParse("01890a5d-ac96-774b-bcce-b302099a8059"),
+	}
+	if len(ids) != len(want) {
+		t.Fatalf("ReadCSVColumn() = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("ids[%d] = %s, want %s", i, ids[i], want[i])
+		}
+	}
+}
+
+func TestReadCSVColumnErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		col  int
+		want string
+	}{
+		{"bad uuid", "a,01890a5d-ac96-774b-bcce-b302099a8057\nb,not-a-uuid\n", 1, "uuid: csv line 2: uuid: parsing"},
+		{"missing column", "a,01890a5d-ac96-774b-bcce-b302099a8057\nb\n", 1, "uuid: csv line 2: no column 1 in record with 1 fields"},
+		{"negative column", "01890a5d-ac96-774b-bcce-b302099a8057\n", -1, "uuid: csv line 1: no column -1"},
+		{"csv syntax", "01890a5d-ac96-774b-bcce-b302099a8057\n\"unterminated\n", 0, "line 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := ReadCSVColumn(strings.NewReader(tt.in), tt.col)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("ReadCSVColumn() error = %v, want it to contain %q", err, tt.want)
+			}
+			if tt.col >= 0 && len(ids) != 1 {
+				t.Errorf("ReadCSVColumn() returned %d ids before the error, want 1", len(ids))
+			}
+		})
+	}
+
+	_, err := ReadCSVColumn(strings.NewReader("x\n"), 0)
+	if _, ok := errors.AsType[*ParseError](err); !ok {
+		t.Errorf("error %v does not wrap *ParseError", err)
+	}
+	_, err = ReadCSVColumn(strings.NewReader("\"x\n"), 0)
+	if _, ok := errors.AsType[*csv.ParseError](err); !ok {
+		t.Errorf("error %v is not a *csv.ParseError", err)
+	}
+}
+
+func TestWriteCSVColumn(t *testing.T) {
+	ids := []UUID{NewV4(), NewV7(), Max}
+	var buf bytes.Buffer
+	if err := WriteCSVColumn(&buf, ids); err != nil {
+		t.Fatalf("WriteCSVColumn() error: %v", err)
+	}
+	if want := ids[0].String() + "\n" + ids[1].String() + "\n" + Max.String() + "\n"; buf.String() != want {
+		t.Errorf("WriteCSVColumn() wrote %q, want %q", buf.String(), want)
+	}
+	got, err := ReadCSVColumn(&buf, 0)
+	if err != nil || len(got) != len(ids) || got[2] != Max {
+		t.Errorf("round trip = %v, %v; want %v", got, err, ids)
+	}
+}
+
+// failWriter fails every write.
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestWriteCSVColumnError(t *testing.T) {
+	// Small inputs fail on Flush, large ones while writing.
+	for _, n := range []int{1, 1000} {
+		if err := WriteCSVColumn(failWriter{}, make([]UUID, n)); err == nil || err.Error() != "disk full" {
+			t.Errorf("WriteCSVColumn(%d ids) error = %v, want disk full", n, err)
+		}
+	}
+}
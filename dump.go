@@ -0,0 +1,81 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// dumpField is a field of a UUID's bit layout, spanning bits first through
+// last (inclusive, bit 0 being the most significant).
+type dumpField struct {
+	name        string
+	first, last int
+}
+
+// fieldLayouts holds the field diagrams of RFC 9562 variant UUIDs per
+// version. V2 follows DCE 1.1, which replaces time_low with a local ID and
+// the low byte of clock_seq with a domain.
+var fieldLayouts = map[Version][]dumpField{
+	V1: {{"time_low", 0, 31}, {"time_mid", 32, 47}, {"ver", 48, 51}, {"time_high", 52, 63}, {"var", 64, 65}, {"clock_seq", 66, 79}, {"node", 80, 127}},
+	V2: {{"local_id", 0, 31}, {"time_mid", 32, 47}, {"ver", 48, 51}, {"time_high", 52, 63}, {"var", 64, 65}, {"clock_seq", 66, 71}, {"domain", 72, 79}, {"node", 80, 127}},
+	V4: {{"random_a", 0, 47}, {"ver", 48, 51}, {"random_b", 52, 63}, {"var", 64, 65}, {"random_c", 66, 127}},
+	V5: {{"sha1_high", 0, 47}, {"ver", 48, 51}, {"sha1_mid", 52, 63}, {"var", 64, 65}, {"sha1_low", 66, 127}},
+	V6: {{"time_high", 0, 31}, {"time_mid", 32, 47}, {"ver", 48, 51}, {"time_low", 52, 63}, {"var", 64, 65}, {"clock_seq", 66, 79}, {"node", 80, 127}},
+	V7: {{"unix_ts_ms", 0, 47}, {"ver", 48, 51}, {"rand_a", 52, 63}, {"var", 64, 65}, {"rand_b", 66, 127}},
+	V8: {{"custom_a", 0, 47}, {"ver", 48, 51}, {"custom_b", 52, 63}, {"var", 64, 65}, {"custom_c", 66, 127}},
+}
+
+// bits returns the value of bits first through last of u. The range must
+// lie within one half of the UUID.
+func (u UUID) bits(first, last int) uint64 {
+	half := binary.BigEndian.Uint64(u[:8])
+	if first >= 64 {
+		half = binary.BigEndian.Uint64(u[8:])
+		first, last = first-64, last-64
+	}
+	return half << first >> (63 - last + first)
+}
+
+// Dump returns a multi-line, annotated breakdown of u for debugging: the
+// bytes, version, variant, and, for RFC 9562 variant UUIDs of a known
+// version, each field with its bit range as in the RFC's field diagrams and
+// the decoded timestamp of V1, V6, and V7 UUIDs.
+//
+//	UUID     018f86ba-c2bb-7abc-9123-456789abcdef
+//	bytes    01 8f 86 ba c2 bb 7a bc 91 23 45 67 89 ab cd ef
+//	version  7 (V7)
+//	variant  RFC9562
+//	time     2024-05-17T13:25:37.595Z
+//	bits 0-47     unix_ts_ms  0x018f86bac2bb
+//	bits 48-51    ver         0x7
+//	bits 52-63    rand_a      0xabc
+//	bits 64-65    var         0b10
+//	bits 66-127   rand_b      0x1123456789abcdef
+func (u UUID) Dump() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "UUID     %s\n", u)
+	fmt.Fprintf(&b, "bytes    % x\n", u[:])
+	fmt.Fprintf(&b, "version  %d (%v)\n", uint8(u.Version()), u.Version())
+	fmt.Fprintf(&b, "variant  %v\n", u.Variant())
+
+	layout, ok := fieldLayouts[u.Version()]
+	if !ok || u.Variant() != VariantRFC9562 {
+		return b.String()
+	}
+	if t, ok := u.TimeOK(); ok {
+		format := "2006-01-02T15:04:05.0000000Z07:00" // 100 ns Gregorian ticks
+		if u.Version() == V7 {
+			format = "2006-01-02T15:04:05.000Z07:00"
+		}
+		fmt.Fprintf(&b, "time     %s\n", t.UTC().Format(format))
+	}
+	for _, f := range layout {
+		value := fmt.Sprintf("%#0*x", (f.last-f.first+4)/4, u.bits(f.first, f.last))
+		if f.name == "var" {
+			value = fmt.Sprintf("%#b", u.bits(f.first, f.last))
+		}
+		fmt.Fprintf(&b, "bits %-8s %-11s %s\n", fmt.Sprintf("%d-%d", f.first, f.last), f.name, value)
+	}
+	return b.String()
+}
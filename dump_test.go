@@ -0,0 +1,90 @@
+package uuid
+
+import "testing"
+
+func TestDump(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want string
+	}{
+		{"V7", MustParse("018f86ba-c2bb-7abc-9123-456789abcdef"), `UUID     018f86ba-c2bb-7abc-9123-456789abcdef
+bytes    01 8f 86 ba c2 bb 7a bc 91 23 45 67 89 ab cd ef
+version  7 (V7)
+variant  RFC9562
+time     2024-05-17T13:25:37.595Z
+bits 0-47     unix_ts_ms  0x018f86bac2bb
+bits 48-51    ver         0x7
+bits 52-63    rand_a      0xabc
+bits 64-65    var         0b10
+bits 66-127   rand_b      0x1123456789abcdef
+`},
+		{"V4", MustParse("00000000-0000-4001-8000-000000000001"), `UUID     00000000-0000-4001-8000-000000000001
+bytes    00 00 00 00 00 00 40 01 80 00 00 00 00 00 00 01
+version  4 (V4)
+variant  RFC9562
+bits 0-47     random_a    0x000000000000
+bits 48-51    ver         0x4
+bits 52-63    random_b    0x001
+bits 64-65    var         0b10
+bits 66-127   random_c    0x0000000000000001
+`},
+		// RFC 9562 Appendix A.1 and A.5.
+		{"V1", MustParse("c232ab00-9414-11ec-b3c8-9f6bdeced846"), `UUID     c232ab00-9414-11ec-b3c8-9f6bdeced846
+bytes    c2 32 ab 00 94 14 11 ec b3 c8 9f 6b de ce d8 46
+version  1 (V1)
+variant  RFC9562
+time     2022-02-22T19:22:22.0000000Z
+bits 0-31     time_low    0xc232ab00
+bits 32-47    time_mid    0x9414
+bits 48-51    ver         0x1
+bits 52-63    time_high   0x1ec
+bits 64-65    var         0b10
+bits 66-79    clock_seq   0x33c8
+bits 80-127   node        0x9f6bdeced846
+`},
+		{"V6", MustParse("1ec9414c-232a-6b00-b3c8-9f6bdeced846"), `UUID     1ec9414c-232a-6b00-b3c8-9f6bdeced846
+bytes    1e c9 41 4c 23 2a 6b 00 b3 c8 9f 6b de ce d8 46
+version  6 (V6)
+variant  RFC9562
+time     2022-02-22T19:22:22.0000000Z
+bits 0-31     time_high   0x1ec9414c
+bits 32-47    time_mid    0x232a
+bits 48-51    ver         0x6
+bits 52-63    time_low    0xb00
+bits 64-65    var         0b10
+bits 66-79    clock_seq   0x33c8
+bits 80-127   node        0x9f6bdeced846
+`},
+		{"V2", MustParse("000003e8-9414-21ec-b302-9f6bdeced846"), `UUID     000003e8-9414-21ec-b302-9f6bdeced846
+bytes    00 00 03 e8 94 14 21 ec b3 02 9f 6b de ce d8 46
+version  2 (V2)
+variant  RFC9562
+bits 0-31     local_id    0x000003e8
+bits 32-47    time_mid    0x9414
+bits 48-51    ver         0x2
+bits 52-63    time_high   0x1ec
+bits 64-65    var         0b10
+bits 66-71    clock_seq   0x33
+bits 72-79    domain      0x02
+bits 80-127   node        0x9f6bdeced846
+`},
+		{"Nil", Nil, `UUID     00000000-0000-0000-0000-000000000000
+bytes    00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00
+version  0 (NIL)
+variant  NCS
+`},
+		{"V4 with Microsoft variant", MustParse("00000000-0000-4000-c000-000000000000"), `UUID     00000000-0000-4000-c000-000000000000
+bytes    00 00 00 00 00 00 40 00 c0 00 00 00 00 00 00 00
+version  4 (V4)
+variant  Microsoft
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.Dump(); got != tt.want {
+				t.Errorf("Dump() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// FromXID embeds the 12 bytes of an rs/xid ID in a V8 UUID. The bytes fill
+// custom_a, custom_b, and the top of custom_c in order, and the remaining
+// 26 bits are zero, so the UUIDs sort like the xids: by creation second,
+// then machine, process, and counter. [UUID.ToXID] extracts the xid again.
+func FromXID(id [12]byte) UUID {
+	return embed96(id)
+}
+
+// ToXID extracts the rs/xid ID embedded by [FromXID]. It returns an error
+// if u is not a V8 UUID with the layout FromXID produces.
+func (u UUID) ToXID() ([12]byte, error) {
+	return extract96(u)
+}
+
+// NewV8Snowflake embeds a Twitter- or Discord-style snowflake ID, with
+// its 41-bit millisecond timestamp counted from epoch, in a V8 UUID.
+// custom_a holds the absolute Unix millisecond, as unix_ts_ms does in V7,
+// so the UUIDs sort by creation time across snowflake sources with
+// different epochs and alongside V7 keys. The 22 worker and sequence bits
+// follow in custom_b and custom_c. [UUID.Snowflake] extracts the ID again.
+// It panics if id is negative or the creation time precedes the Unix epoch.
+func NewV8Snowflake(id int64, epoch time.Time) UUID {
+	if id < 0 {
+		panic(fmt.Sprintf("uuid: negative snowflake ID %d", id))
+	}
+	ms := epoch.UnixMilli() + id>>22
+	if ms < 0 || ms >= 1<<48 {
+		panic(fmt.Sprintf("uuid: snowflake ID %d with epoch %v is outside the 48-bit Unix millisecond range", id, epoch))
+	}
+	var u UUID
+	binary.BigEndian.PutUint64(u[:8], uint64(ms)<<16|0x8000|uint64(id>>10&0xfff))
+	binary.BigEndian.PutUint64(u[8:], 0x8000000000000000|uint64(id&0x3ff)<<52)
+	return u
+}
+
+// Snowflake extracts the snowflake ID embedded by [NewV8Snowflake], counting
+// its timestamp from epoch again. It returns an error if u is not a V8 UUID
+// with that layout or its creation time cannot be expressed as a 41-bit
+// offset from epoch.
+func (u UUID) Snowflake(epoch time.Time) (int64, error) {
+	head := binary.BigEndian.Uint64(u[:8])
+	tail := binary.BigEndian.Uint64(u[8:])
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 || tail&(1<<52-1) != 0 {
+		return 0, fmt.Errorf("uuid: %s does not embed a snowflake ID", u)
+	}
+	delta := int64(head>>16) - epoch.UnixMilli()
+	if delta < 0 || delta >= 1<<41 {
+		return 0, fmt.Errorf("uuid: %s is outside the snowflake range for epoch %v", u, epoch)
+	}
+	return delta<<22 | int64(head&0xfff)<<10 | int64(tail>>52&0x3ff), nil
+}
+
+// FromObjectID embeds the 12 bytes of a MongoDB ObjectID in a V8 UUID.
+// custom_a holds the ObjectID's creation second as a Unix millisecond, as
+// unix_ts_ms does in V7, so the UUIDs sort by creation time like the
+// ObjectIDs and alongside V7 keys. The 8 random and counter bytes follow in
+// custom_b and custom_c. [UUID.ToObjectID] extracts the ObjectID again.
+func FromObjectID(id [12]byte) UUID {
+	ms := uint64(binary.BigEndian.Uint32(id[:4])) * 1000
+	rest := binary.BigEndian.Uint64(id[4:])
+	var u UUID
+	binary.BigEndian.PutUint64(u[:8], ms<<16|0x8000|rest>>52)
+	binary.BigEndian.PutUint64(u[8:], 0x8000000000000000|(rest&(1<<52-1))<<10)
+	return u
+}
+
+// ToObjectID extracts the MongoDB ObjectID embedded by [FromObjectID]. It
+// returns an error if u is not a V8 UUID with that layout.
+func (u UUID) ToObjectID() ([12]byte, error) {
+	var id [12]byte
+	head := binary.BigEndian.Uint64(u[:8])
+	tail := binary.BigEndian.Uint64(u[8:])
+	ms := head >> 16
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 || tail&(1<<10-1) != 0 || ms%1000 != 0 || ms/1000 > 1<<32-1 {
+		return id, fmt.Errorf("uuid: %s does not embed an ObjectID", u)
+	}
+	binary.BigEndian.PutUint32(id[:4], uint32(ms/1000))
+	binary.BigEndian.PutUint64(id[4:], (head&0xfff)<<52|(tail&(1<<62-1))>>10)
+	return id, nil
+}
+
+// embed96 packs 96 bits into the 122 custom bits of a V8 UUID, most
+// significant first, leaving the low 26 bits of custom_c zero.
+func embed96(id [12]byte) UUID {
+	var u UUID
+	copy(u[:6], id[:6])
+	lo := uint64(id[6])<<40 | uint64(id[7])<<32 | uint64(binary.BigEndian.Uint32(id[8:]))
+	binary.BigEndian.PutUint16(u[6:8], 0x8000|uint16(lo>>36))
+	binary.BigEndian.PutUint64(u[8:], 0x8000000000000000|(lo&(1<<36-1))<<26)
+	return u
+}
+
+// extract96 reverses embed96.
+func extract96(u UUID) ([12]byte, error) {
+	var id [12]byte
+	tail := binary.BigEndian.Uint64(u[8:])
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 || tail&(1<<26-1) != 0 {
+		return id, fmt.Errorf("uuid: %s does not embed a 12-byte ID", u)
+	}
+	copy(id[:6], u[:6])
+	lo := uint64(binary.BigEndian.Uint16(u[6:8])&0x0fff)<<36 | (tail&(1<<62-1))>>26
+	id[6] = byte(lo >> 40)
+	id[7] = byte(lo >> 32)
+	binary.BigEndian.PutUint32(id[8:], uint32(lo))
+	return id, nil
+}
@@ -0,0 +1,168 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestXID(t *testing.T) {
+	// The xid 9m4e2mr0ui3e8a215n4g from the rs/xid documentation.
+	id := [12]byte{0x4d, 0x88, 0xe1, 0x5b, 0x60, 0xf4, 0x86, 0xe4, 0x28, 0x41, 0x2d, 0xc9}
+	u := FromXID(id)
+	if want := MustParse("4d88e15b-60f4-886e-90a1-04b724000000"); u != want {
+		t.Errorf("FromXID() = %s, want %s", u, want)
+	}
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 {
+		t.Errorf("FromXID() = %v/%v, want V8/RFC9562", u.Version(), u.Variant())
+	}
+	back, err := u.ToXID()
+	if err != nil || back != id {
+		t.Errorf("ToXID() = %x, %v, want %x", back, err, id)
+	}
+
+	for _, bad := range []UUID{
+		NewV4(),
+		MustParse("4d88e15b-60f4-886e-90a1-04b724000001"), // low bits set
+		MustParse("4d88e15b-60f4-886e-d0a1-04b724000000"), // Microsoft variant
+	} {
+		if _, err := bad.ToXID(); err == nil {
+			t.Errorf("%s.ToXID() succeeded, want error", bad)
+		}
+	}
+}
+
+var discordEpoch = time.UnixMilli(1420070400000)
+
+func TestSnowflake(t *testing.T) {
+	// Example from the Discord API reference: created 2016-04-30 11:18:25.796 UTC.
+	const id = 175928847299117063
+	u := NewV8Snowflake(id, discordEpoch)
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 {
+		t.Errorf("NewV8Snowflake() = %v/%v, want V8/RFC9562", u.Version(), u.Variant())
+	}
+	if got, want := binary.BigEndian.Uint64(u[:8])>>16, uint64(1462015105796); got != want {
+		t.Errorf("NewV8Snowflake() timestamp = %d, want %d", got, want)
+	}
+	back, err := u.Snowflake(discordEpoch)
+	if err != nil || back != id {
+		t.Errorf("Snowflake() = %d, %v, want %d", back, err, id)
+	}
+
+	// Snowflakes with different epochs sort by absolute creation time.
+	twitter := NewV8Snowflake(1<<22, time.UnixMilli(1288834974657))
+	if Compare(twitter, u) >= 0 {
+		t.Errorf("earlier snowflake %s sorts after %s", twitter, u)
+	}
+	// Sequence and worker bits order IDs within one millisecond.
+	if a, b := NewV8Snowflake(id, discordEpoch), NewV8Snowflake(id+1, discordEpoch); Compare(a, b) >= 0 {
+		t.Errorf("NewV8Snowflake(%d) = %s, not before %s", id, a, b)
+	}
+	last := NewV8Snowflake(1<<63-1, time.UnixMilli(0))
+	if got, err := last.Snowflake(time.UnixMilli(0)); err != nil || got != 1<<63-1 {
+		t.Errorf("Snowflake(max) = %d, %v", got, err)
+	}
+}
+
+func TestSnowflakeErrors(t *testing.T) {
+	u := NewV8Snowflake(175928847299117063, discordEpoch)
+	for _, epoch := range []time.Time{discordEpoch.Add(time.Hour * 24 * 365 * 10), time.UnixMilli(-1 << 41)} {
+		if _, err := u.Snowflake(epoch); err == nil {
+			t.Errorf("Snowflake(%v) succeeded, want range error", epoch)
+		}
+	}
+	for _, bad := range []UUID{NewV4(), FromXID([12]byte{11: 1})} {
+		if _, err := bad.Snowflake(discordEpoch); err == nil {
+			t.Errorf("%s.Snowflake() succeeded, want error", bad)
+		}
+	}
+
+	for _, tt := range []struct {
+		id    int64
+		epoch time.Time
+	}{
+		{-1, discordEpoch},
+		{1 << 22, time.UnixMilli(-2)},
+		{1 << 22, time.UnixMilli(1 << 48)},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewV8Snowflake(%d, %v) did not panic", tt.id, tt.epoch)
+				}
+			}()
+			NewV8Snowflake(tt.id, tt.epoch)
+		}()
+	}
+}
+
+func TestObjectID(t *testing.T) {
+	// ObjectID 507f1f77bcf86cd799439011, created 2012-10-17 21:13:27 UTC.
+	id := [12]byte{0x50, 0x7f, 0x1f, 0x77, 0xbc, 0xf8, 0x6c, 0xd7, 0x99, 0x43, 0x90, 0x11}
+	u := FromObjectID(id)
+	if u.Version() != V8 || u.Variant() != VariantRFC9562 {
+		t.Errorf("FromObjectID() = %v/%v, want V8/RFC9562", u.Version(), u.Variant())
+	}
+	if got, want := binary.BigEndian.Uint64(u[:8])>>16, uint64(1350508407000); got != want {
+		t.Errorf("FromObjectID() timestamp = %d, want %d", got, want)
+	}
+	back, err := u.ToObjectID()
+	if err != nil || back != id {
+		t.Errorf("ToObjectID() = %x, %v, want %x", back, err, id)
+	}
+
+	ids := [][12]byte{
+		{0, 0, 0, 1},
+		{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		{0, 0, 0, 1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		{0, 0, 0, 2},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	var us []UUID
+	for _, id := range ids {
+		u := FromObjectID(id)
+		if back, err := u.ToObjectID(); err != nil || back != id {
+			t.Errorf("ToObjectID(%s) = %x, %v, want %x", u, back, err, id)
+		}
+		us = append(us, u)
+	}
+	if !slices.IsSortedFunc(us, Compare) {
+		t.Errorf("FromObjectID does not preserve order: %v", us)
+	}
+
+	for _, bad := range []UUID{
+		NewV4(),
+		FromXID(id),
+		NewV8Snowflake(175928847299117063, discordEpoch),
+		MustParse("03e80000-0000-8fff-bfff-fffffffffc00"), // second overflows 32 bits
+	} {
+		if _, err := bad.ToObjectID(); err == nil {
+			t.Errorf("%s.ToObjectID() succeeded, want error", bad)
+		}
+	}
+}
+
+func TestXIDOrder(t *testing.T) {
+	ids := [][12]byte{
+		{0, 0, 0, 1},
+		{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		{0, 0, 0, 1, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff},
+		{0, 0, 0, 1, 0, 0, 0xff, 0, 0, 0, 0, 0},
+		{0, 0, 0, 2},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	var us []UUID
+	for _, id := range ids {
+		us = append(us, FromXID(id))
+	}
+	if !slices.IsSortedFunc(us, Compare) {
+		t.Errorf("FromXID does not preserve order: %v", us)
+	}
+	for i, u := range us {
+		if back, err := u.ToXID(); err != nil || !bytes.Equal(back[:], ids[i][:]) {
+			t.Errorf("ToXID(%s) = %x, %v, want %x", u, back, err, ids[i])
+		}
+	}
+}
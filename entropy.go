@@ -0,0 +1,36 @@
+package uuid
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+var entropyFallback atomic.Pointer[io.Reader]
+
+// SetEntropyFallback registers r as the entropy source used when crypto/rand
+// reports a failure. With the standard Go toolchain crypto/rand never fails
+// (it uses crypto.getRandomValues on js/wasm and random_get on wasip1), so
+// the fallback is only consulted by TinyGo builds, whose crypto/rand returns
+// an error on targets without a hardware or OS random number generator.
+// Without a fallback, such builds panic rather than issue predictable UUIDs.
+func SetEntropyFallback(r io.Reader) {
+	entropyFallback.Store(&r)
+}
+
+// readOrFallback fills b with read, falling back to the reader registered
+// with [SetEntropyFallback] if read fails, and panics if neither can fill b.
+// It is the randRead of TinyGo builds, kept here so that the fallback is
+// tested with the standard toolchain.
+func readOrFallback(read func([]byte) (int, error), b []byte) (int, error) {
+	n, err := read(b)
+	if err == nil {
+		return n, nil
+	}
+	if r := entropyFallback.Load(); r != nil && *r != nil {
+		if n, err = io.ReadFull(*r, b); err == nil {
+			return n, nil
+		}
+	}
+	panic(fmt.Errorf("uuid: no entropy available: %w", err))
+}
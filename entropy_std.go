@@ -0,0 +1,11 @@
+//go:build !tinygo
+
+package uuid
+
+import "crypto/rand"
+
+// randRead fills b from crypto/rand, which cannot fail with the standard Go
+// toolchain.
+func randRead(b []byte) (int, error) {
+	return rand.Read(b)
+}
@@ -0,0 +1,69 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+type countingReader struct{ reads int }
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	r.reads++
+	return len(b), nil
+}
+
+func TestSetEntropyFallbackUnusedWithStdCrypto(t *testing.T) {
+	r := &countingReader{}
+	SetEntropyFallback(r)
+	defer SetEntropyFallback(nil)
+
+	NewV4()
+	NewV7()
+	NewPool().NewV4()
+	if r.reads != 0 {
+		t.Errorf("fallback read %d times, want 0 while crypto/rand works", r.reads)
+	}
+	if got := entropyFallback.Load(); got == nil || *got != io.Reader(r) {
+		t.Error("SetEntropyFallback did not register the reader")
+	}
+}
+
+func TestReadOrFallback(t *testing.T) {
+	defer SetEntropyFallback(nil)
+	unavailable := errors.New("no RNG")
+	failing := func([]byte) (int, error) { return 0, unavailable }
+
+	b := make([]byte, 4)
+	if n, err := readOrFallback(func(b []byte) (int, error) { return copy(b, "good"), nil }, b); n != 4 || err != nil || string(b) != "good" {
+		t.Errorf("working read = %d, %v, %q", n, err, b)
+	}
+
+	SetEntropyFallback(bytes.NewReader([]byte("fallback")))
+	if n, err := readOrFallback(failing, b); n != 4 || err != nil || string(b) != "fall" {
+		t.Errorf("fallback read = %d, %v, %q", n, err, b)
+	}
+
+	broken := errors.New("fallback broken")
+	for _, tt := range []struct {
+		name     string
+		fallback io.Reader
+		want     error
+	}{
+		{"no fallback", nil, unavailable},
+		{"failed fallback", iotest.ErrReader(broken), broken},
+	} {
+		SetEntropyFallback(tt.fallback)
+		func() {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, tt.want) {
+					t.Errorf("%s: recover() = %v, want an error wrapping %v", tt.name, err, tt.want)
+				}
+			}()
+			readOrFallback(failing, b)
+			t.Errorf("%s: readOrFallback did not panic", tt.name)
+		}()
+	}
+}
@@ -0,0 +1,11 @@
+//go:build tinygo
+
+package uuid
+
+import "crypto/rand"
+
+// randRead fills b from crypto/rand, falling back to the reader registered
+// with [SetEntropyFallback] on targets where crypto/rand is unavailable.
+func randRead(b []byte) (int, error) {
+	return readOrFallback(rand.Read, b)
+}
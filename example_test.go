@@ -1,6 +1,7 @@
 package uuid_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 
@@ -123,3 +124,18 @@ func ExampleFromBytes() {
 	fmt.Println(id)
 	// Output: 6ba7b810-9dad-11d1-80b4-00c04fd430c8
 }
+
+type V4OrV7 struct{}
+
+func (V4OrV7) Policy() uuid.Policy {
+	return uuid.Policy{Versions: []uuid.Version{uuid.V4, uuid.V7}, RejectNil: true}
+}
+
+func ExampleChecked() {
+	var req struct {
+		ID uuid.Checked[V4OrV7] `json:"id"`
+	}
+	err := json.Unmarshal([]byte(`{"id":"00000000-0000-0000-0000-000000000000"}`), &req)
+	fmt.Println(err)
+	// Output: uuid: 00000000-0000-0000-0000-000000000000 rejected: Nil UUID not allowed
+}
@@ -0,0 +1,81 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// Fields is the structured decomposition of a UUID returned by
+// [UUID.Fields], for tooling that explains IDs to people.
+type Fields struct {
+	Version Version
+	Variant Variant
+
+	// Time is the embedded timestamp of V1, V6, and V7 UUIDs, as decoded by
+	// [UUID.TimeOK]; HasTime is false and Time is zero for other versions.
+	Time    time.Time
+	HasTime bool
+
+	// Raw bit fields, as returned by the accessors of the same names.
+	// RandA and RandB are zero for V1, V2, and V6 UUIDs, whose bits in
+	// those positions belong to the timestamp, ClockSeq, and Node.
+	TimestampBits uint64
+	RandA         uint16
+	RandB         uint64
+
+	// ClockSeq and Node are the 14-bit clock sequence and 48-bit node ID of
+	// V1, V2, and V6 UUIDs; they are zero for other versions.
+	ClockSeq uint16
+	Node     [6]byte
+}
+
+// Fields decomposes u into its version, variant, timestamp, and raw fields.
+func (u UUID) Fields() Fields {
+	f := Fields{
+		Version:       u.Version(),
+		Variant:       u.Variant(),
+		TimestampBits: u.TimestampBits(),
+	}
+	f.Time, f.HasTime = u.TimeOK()
+	switch f.Version {
+	case V1, V2, V6:
+		f.ClockSeq = binary.BigEndian.Uint16(u[8:10]) & 0x3fff
+		f.Node = [6]byte(u[10:])
+	default:
+		f.RandA = u.RandA()
+		f.RandB = u.RandB()
+	}
+	return f
+}
+
+// TimestampBits returns the raw timestamp of u for versions that embed one:
+// the 48-bit unix_ts_ms of a V7 UUID, or the 60-bit count of 100 ns ticks
+// since 1582-10-15 that V1 and V6 UUIDs split across time_low, time_mid, and
+// time_high in their respective orders. It returns 0 for other versions,
+// including V2, whose time_low field holds a local ID instead.
+func (u UUID) TimestampBits() uint64 {
+	switch u.Version() {
+	case V7:
+		return binary.BigEndian.Uint64(u[:8]) >> 16
+	case V1, V6:
+		return uint64(gregorianTicks(u))
+	}
+	return 0
+}
+
+// RandA returns the 12 bits following the version field (bits 52–63):
+// rand_a of a V7 UUID, which holds the Method 3 sub-millisecond fraction or
+// counter, or the corresponding random_b, custom_b, or hash bits of V4, V8,
+// and V5 UUIDs. For V1, V2, and V6 UUIDs these bits are part of the
+// timestamp; use [UUID.TimestampBits] instead.
+func (u UUID) RandA() uint16 {
+	return binary.BigEndian.Uint16(u[6:8]) & 0x0fff
+}
+
+// RandB returns the 62 bits following the RFC 9562 variant field
+// (bits 66–127): rand_b of a V7 UUID, or the corresponding random_c,
+// custom_c, or hash bits of V4, V8, and V5 UUIDs. For V1, V2, and V6 UUIDs
+// these bits hold the clock sequence and node; use [UUID.Fields] instead.
+func (u UUID) RandB() uint64 {
+	return binary.BigEndian.Uint64(u[8:]) & (1<<62 - 1)
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFieldBits(t *testing.T) {
+	tests := []struct {
+		name      string
+		uuid      UUID
+		timestamp uint64
+		randA     uint16
+		randB     uint64
+	}{
+		{"V7", MustParse("018f86ba-c2bb-7abc-9123-456789abcdef"), 0x018f86bac2bb, 0xabc, 0x1123456789abcdef},
+		{"V4", MustParse("018f86ba-c2bb-4abc-b123-456789abcdef"), 0, 0xabc, 0x3123456789abcdef},
+		{"Max", Max, 0, 0xfff, 1<<62 - 1},
+		// RFC 9562 Appendix A.1 and A.5: both encode tick 0x1ec9414c232ab00.
+		{"V1", MustParse("c232ab00-9414-11ec-b3c8-9f6bccd3b5bb"), 0x1ec9414c232ab00, 0x1ec, 0x33c89f6bccd3b5bb},
+		{"V6", MustParse("1ec9414c-232a-6b00-b3c8-9f6bccd3b5bb"), 0x1ec9414c232ab00, 0xb00, 0x33c89f6bccd3b5bb},
+		{"V2", MustParse("000003e8-9414-21ec-b302-9f6bccd3b5bb"), 0, 0x1ec, 0x3302_9f6bccd3b5bb},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.TimestampBits(); got != tt.timestamp {
+				t.Errorf("TimestampBits() = %#x, want %#x", got, tt.timestamp)
+			}
+			if got := tt.uuid.RandA(); got != tt.randA {
+				t.Errorf("RandA() = %#x, want %#x", got, tt.randA)
+			}
+			if got := tt.uuid.RandB(); got != tt.randB {
+				t.Errorf("RandB() = %#x, want %#x", got, tt.randB)
+			}
+		})
+	}
+}
+
+func TestTimestampBitsMatchesTime(t *testing.T) {
+	u := NewV7()
+	if int64(u.TimestampBits()) != u.Time().UnixMilli() {
+		t.Errorf("TimestampBits() = %d, Time() = %d ms", u.TimestampBits(), u.Time().UnixMilli())
+	}
+	for _, u := range []UUID{NewV1(), NewV6()} {
+		if got, _ := u.TimeOK(); !gregorianTime(int64(u.TimestampBits())).Equal(got) {
+			t.Errorf("%v TimestampBits() = %#x, TimeOK() = %v", u.Version(), u.TimestampBits(), got)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	v1 := MustParse("c232ab00-9414-11ec-b3c8-9f6bccd3b5bb") // RFC 9562 Appendix A.1
+	got := v1.Fields()
+	want := Fields{
+		Version:       V1,
+		Variant:       VariantRFC9562,
+		Time:          time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC),
+		HasTime:       true,
+		TimestampBits: 0x1ec9414c232ab00,
+		ClockSeq:      0x33c8,
+		Node:          [6]byte{0x9f, 0x6b, 0xcc, 0xd3, 0xb5, 0xbb},
+	}
+	if !got.Time.Equal(want.Time) {
+		t.Errorf("V1 Fields().Time = %v, want %v", got.Time, want.Time)
+	}
+	got.Time = want.Time
+	if got != want {
+		t.Errorf("V1 Fields() = %+v, want %+v", got, want)
+	}
+
+	v7 := MustParse("018f86ba-c2bb-7abc-9123-456789abcdef")
+	if f := v7.Fields(); !f.HasTime || f.TimestampBits != 0x018f86bac2bb || f.RandA != 0xabc || f.ClockSeq != 0 {
+		t.Errorf("V7 Fields() = %+v", f)
+	}
+	if f := NewV4().Fields(); f.HasTime || !f.Time.IsZero() || f.Version != V4 {
+		t.Errorf("V4 Fields() = %+v, want no time", f)
+	}
+}
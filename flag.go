@@ -0,0 +1,28 @@
+package uuid
+
+import "flag"
+
+// Set parses s in the strict 36-character form into u. Together with
+// [UUID.String] it implements [flag.Value], so *UUID can be registered with
+// [flag.Var] or a [flag.FlagSet].
+func (u *UUID) Set(s string) error {
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// Flag defines a UUID flag with the given name, default value, and usage
+// string on [flag.CommandLine]. It returns a pointer to the variable that
+// stores the flag's value:
+//
+//	tenant := uuid.Flag("tenant-id", uuid.Nil, "tenant to operate on")
+//	flag.Parse()
+func Flag(name string, def UUID, usage string) *UUID {
+	p := new(UUID)
+	*p = def
+	flag.Var(p, name, usage)
+	return p
+}
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlagSet(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	var got UUID
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&got, "id", "")
+
+	if err := fs.Parse([]string{"-id=" + u.String()}); err != nil || got != u {
+		t.Errorf("Parse() = %s, %v, want %s", got, err, u)
+	}
+	if err := fs.Parse([]string{"-id=not-a-uuid"}); err == nil {
+		t.Error("Parse(invalid) succeeded, want error")
+	}
+	if got != u {
+		t.Errorf("failed Set modified value: %s", got)
+	}
+}
+
+func TestFlag(t *testing.T) {
+	def := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	p := Flag("uuid-test-tenant", def, "tenant")
+	if *p != def {
+		t.Errorf("Flag() default = %s, want %s", *p, def)
+	}
+	f := flag.Lookup("uuid-test-tenant")
+	if f == nil || f.DefValue != def.String() || f.Usage != "tenant" {
+		t.Fatalf("flag.Lookup() = %+v", f)
+	}
+	if err := flag.Set("uuid-test-tenant", Max.String()); err != nil || *p != Max {
+		t.Errorf("flag.Set() = %s, %v, want %s", *p, err, Max)
+	}
+}
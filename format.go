@@ -1,7 +1,9 @@
 package uuid
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 )
 
@@ -15,6 +17,58 @@ func (u UUID) String() string {
 	return string(buf[:])
 }
 
+// Format implements [fmt.Formatter]:
+//
+//	%s, %v  canonical form, as String
+//	%+v     canonical form annotated with version and variant:
+//	        6ba7b810-9dad-11d1-80b4-00c04fd430c8 (V1, RFC9562)
+//	%q      canonical form, double-quoted
+//	%x, %X  32 hex digits without hyphens, lower or upper case
+//	%#v     Go syntax, as GoString
+//
+// Width and the '-' flag pad the output as for strings.
+//
+// fmt passes the '+' flag down to nested values, so a struct printed with
+// %+v also shows its UUID fields annotated: {ID:6ba7b810-… (V1, RFC9562)}.
+// Print such structs with %v where logs are parsed by UUID.
+func (u UUID) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('#'):
+			fmt.Fprint(s, u.GoString())
+		case s.Flag('+'):
+			fmt.Fprintf(s, fmt.FormatString(s, 's'), u.String()+" ("+u.Version().String()+", "+u.Variant().String()+")")
+		default:
+			fmt.Fprintf(s, fmt.FormatString(s, 's'), u.String())
+		}
+	case 's':
+		fmt.Fprintf(s, fmt.FormatString(s, 's'), u.String())
+	case 'q':
+		fmt.Fprintf(s, fmt.FormatString(s, 'q'), u.String())
+	case 'x', 'X':
+		var buf [32]byte
+		encodeCompact(buf[:], u)
+		if verb == 'X' {
+			for i, c := range buf {
+				if c >= 'a' {
+					buf[i] = c - ('a' - 'A')
+				}
+			}
+		}
+		fmt.Fprintf(s, fmt.FormatString(s, 's'), buf[:])
+	default:
+		fmt.Fprintf(s, "%%!%c(uuid.UUID=%s)", verb, u.String())
+	}
+}
+
+// GoString returns u as a Go expression, uuid.MustParse("..."), so %#v
+// output in test failures can be pasted back into code.
+// It implements [fmt.GoStringer].
+func (u UUID) GoString() string {
+	return `uuid.MustParse("` + u.String() + `")`
+}
+
 // URN returns the UUID in URN form: urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
 func (u UUID) URN() string {
 	var buf [45]byte
@@ -31,6 +85,59 @@ func (u UUID) AppendText(b []byte) ([]byte, error) {
 	return b, nil
 }
 
+// AppendJSON appends the JSON string form of u, the 36-character hyphenated
+// representation in double quotes, to b. It is intended for hand-rolled JSON
+// encoders and does not allocate if b has sufficient capacity.
+func (u UUID) AppendJSON(b []byte) []byte {
+	b = grow(b, 38)
+	dst := b[len(b)-38:]
+	dst[0] = '"'
+	encodeHex(dst[1:], u)
+	dst[37] = '"'
+	return b
+}
+
+// MarshalJSON returns u as a quoted 36-character hyphenated string.
+// It implements [encoding/json.Marshaler].
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(make([]byte, 0, 38)), nil
+}
+
+// UnmarshalJSON parses a JSON string holding the strict 36-character form
+// without copying it. A JSON null sets u to [Nil].
+// It implements [encoding/json.Unmarshaler].
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonText(data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		*u = Nil
+		return nil
+	}
+	return u.UnmarshalText(s)
+}
+
+// jsonText returns the contents of the JSON string data, sharing its memory
+// unless the string contains escapes, or reports that data is JSON null.
+func jsonText(data []byte) (s []byte, null bool, err error) {
+	if string(data) == "null" {
+		return nil, true, nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false, &ParseError{Input: string(data), Msg: "expected JSON string"}
+	}
+	s = data[1 : len(data)-1]
+	if bytes.IndexByte(s, '\\') >= 0 {
+		var unquoted string
+		if err := json.Unmarshal(data, &unquoted); err != nil {
+			return nil, false, err
+		}
+		s = []byte(unquoted)
+	}
+	return s, false, nil
+}
+
 // AppendBinary appends the raw 16-byte representation of u to b.
 // It implements [encoding.BinaryAppender].
 func (u UUID) AppendBinary(b []byte) ([]byte, error) {
@@ -39,7 +146,6 @@ func (u UUID) AppendBinary(b []byte) ([]byte, error) {
 
 // MarshalText returns the 36-character hyphenated representation.
 // It implements [encoding.TextMarshaler].
-// JSON encoding uses this method automatically.
 func (u UUID) MarshalText() ([]byte, error) {
 	var buf [36]byte
 	encodeHex(buf[:], u)
@@ -50,13 +156,13 @@ func (u UUID) MarshalText() ([]byte, error) {
 // It implements [encoding.TextUnmarshaler].
 func (u *UUID) UnmarshalText(data []byte) error {
 	if len(data) != 36 {
-		return &ParseError{Input: string(data), Msg: "expected 36-character hyphenated format"}
+		return &ParseError{Input: string(data), Msg: "expected 36-character hyphenated format", canonical: true}
 	}
 	if data[8] != '-' || data[13] != '-' || data[18] != '-' || data[23] != '-' {
-		return &ParseError{Input: string(data), Msg: "expected hyphens at positions 8, 13, 18, 23"}
+		return &ParseError{Input: string(data), Msg: "expected hyphens at positions 8, 13, 18, 23", canonical: true}
 	}
 	if !parseHexBytes(u, data, 0) {
-		return &ParseError{Input: string(data), Msg: "invalid hex character"}
+		return &ParseError{Input: string(data), Msg: "invalid hex character", canonical: true}
 	}
 	return nil
 }
@@ -79,6 +185,31 @@ func (u *UUID) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// EncodeAll returns the raw 16-byte representations of ids concatenated in
+// one allocation, for persisting large ID lists in caches and snapshot
+// files. Decode with [DecodeAll].
+func EncodeAll(ids []UUID) []byte {
+	b := make([]byte, 0, len(ids)*16)
+	for _, u := range ids {
+		b = append(b, u[:]...)
+	}
+	return b
+}
+
+// DecodeAll decodes the concatenated raw UUIDs produced by [EncodeAll] in
+// one allocation. It returns a [*LengthError] if len(data) is not a multiple
+// of 16.
+func DecodeAll(data []byte) ([]UUID, error) {
+	if len(data)%16 != 0 {
+		return nil, &LengthError{Got: len(data), Want: "a multiple of 16 bytes"}
+	}
+	ids := make([]UUID, len(data)/16)
+	for i := range ids {
+		ids[i] = UUID(data[i*16:])
+	}
+	return ids, nil
+}
+
 // encodeHex writes the 36-byte hyphenated hex representation of u into dst.
 // dst must be at least 36 bytes.
 func encodeHex(dst []byte, u UUID) {
@@ -121,6 +252,15 @@ func encodeHex(dst []byte, u UUID) {
 	dst[35] = hex[u[15]&0x0f]
 }
 
+// encodeCompact writes the 32 hex digits of u, without hyphens, into dst.
+// dst must be at least 32 bytes.
+func encodeCompact(dst []byte, u UUID) {
+	for i, b := range u {
+		dst[2*i] = hexDigits[b>>4]
+		dst[2*i+1] = hexDigits[b&0x0f]
+	}
+}
+
 // grow appends n zero bytes to b and returns the extended slice.
 func grow(b []byte, n int) []byte {
 	l := len(b)
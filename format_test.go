@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -50,6 +51,19 @@ func TestAppendText(t *testing.T) {
 	}
 }
 
+func TestAppendJSON(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	buf := u.AppendJSON([]byte(`{"id":`))
+	buf = append(buf, '}')
+	if string(buf) != `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}` {
+		t.Errorf("AppendJSON() = %s", buf)
+	}
+	want, _ := json.Marshal(u)
+	if got := u.AppendJSON(nil); string(got) != string(want) {
+		t.Errorf("AppendJSON(nil) = %s, want json.Marshal output %s", got, want)
+	}
+}
+
 func TestAppendBinary(t *testing.T) {
 	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	buf, err := u.AppendBinary(nil)
@@ -155,6 +169,40 @@ func TestUnmarshalBinaryError(t *testing.T) {
 	}
 }
 
+func TestEncodeAll(t *testing.T) {
+	ids := []UUID{NewV4(), Nil, Max, NewV7()}
+	b := EncodeAll(ids)
+	if len(b) != 64 || UUID(b[48:]) != ids[3] {
+		t.Fatalf("EncodeAll() = %x", b)
+	}
+	got, err := DecodeAll(b)
+	if err != nil {
+		t.Fatalf("DecodeAll() error: %v", err)
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("DecodeAll() = %v, want %v", got, ids)
+	}
+	for i := range ids {
+		if got[i] != ids[i] {
+			t.Errorf("DecodeAll()[%d] = %s, want %s", i, got[i], ids[i])
+		}
+	}
+	if got, err := DecodeAll(EncodeAll(nil)); err != nil || len(got) != 0 {
+		t.Errorf("DecodeAll(empty) = %v, %v, want [], nil", got, err)
+	}
+}
+
+func TestDecodeAllError(t *testing.T) {
+	_, err := DecodeAll(make([]byte, 33))
+	lerr, ok := errors.AsType[*LengthError](err)
+	if !ok {
+		t.Fatalf("error type = %T, want *LengthError", err)
+	}
+	if lerr.Got != 33 || lerr.Error() != "uuid: unexpected length 33, want a multiple of 16 bytes" {
+		t.Errorf("LengthError = %v", lerr)
+	}
+}
+
 func TestMarshalTextRoundTrip(t *testing.T) {
 	original := MustParse("550e8400-e29b-41d4-a716-446655440000")
 	b, err := original.MarshalText()
@@ -229,6 +277,84 @@ func TestJSONNull(t *testing.T) {
 	}
 }
 
+func TestFormat(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%s", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"%v", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"%+v", "6ba7b810-9dad-11d1-80b4-00c04fd430c8 (V1, RFC9562)"},
+		{"%q", `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`},
+		{"%#q", "`6ba7b810-9dad-11d1-80b4-00c04fd430c8`"},
+		{"%x", "6ba7b8109dad11d180b400c04fd430c8"},
+		{"%X", "6BA7B8109DAD11D180B400C04FD430C8"},
+		{"%#v", `uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`},
+		{"%40s|", "    6ba7b810-9dad-11d1-80b4-00c04fd430c8|"},
+		{"%-34x|", "6ba7b8109dad11d180b400c04fd430c8  |"},
+		{"%d", "%!d(uuid.UUID=6ba7b810-9dad-11d1-80b4-00c04fd430c8)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, u); got != tt.want {
+			t.Errorf("Sprintf(%q) = %s, want %s", tt.format, got, tt.want)
+		}
+	}
+	if got, want := fmt.Sprintf("%#v", []UUID{Nil}), `[]uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000000")}`; got != want {
+		t.Errorf("Sprintf(%%#v, []UUID) = %s, want %s", got, want)
+	}
+	if got := fmt.Sprint([]UUID{Nil, Max}); got != "[00000000-0000-0000-0000-000000000000 ffffffff-ffff-ffff-ffff-ffffffffffff]" {
+		t.Errorf("Sprint([]UUID) = %s", got)
+	}
+
+	// The '+' flag reaches nested fields, as documented on Format.
+	row := struct{ ID UUID }{u}
+	if got, want := fmt.Sprintf("%+v", row), "{ID:6ba7b810-9dad-11d1-80b4-00c04fd430c8 (V1, RFC9562)}"; got != want {
+		t.Errorf("Sprintf(%%+v, struct) = %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", row), "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"; got != want {
+		t.Errorf("Sprintf(%%v, struct) = %s, want %s", got, want)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	want := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		in   string
+		want UUID
+	}{
+		{`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, want},
+		{`"\u0036ba7b810-9dad-11d1-80b4-00c04fd430c8"`, want},
+		{`null`, Nil},
+	}
+	for _, tt := range tests {
+		got := Max
+		if err := got.UnmarshalJSON([]byte(tt.in)); err != nil || got != tt.want {
+			t.Errorf("UnmarshalJSON(%s) = %s, %v, want %s", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, in := range []string{``, `"`, `42`, `"6ba7b8109dad11d180b400c04fd430c8"`, `"\x"`} {
+		var got UUID
+		if err := got.UnmarshalJSON([]byte(in)); err == nil {
+			t.Errorf("UnmarshalJSON(%s) succeeded, want error", in)
+		}
+	}
+	var got UUID
+	err := got.UnmarshalJSON([]byte(`42`))
+	if _, ok := errors.AsType[*ParseError](err); !ok {
+		t.Errorf("UnmarshalJSON(42) error = %v, want *ParseError", err)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	got, err := u.MarshalJSON()
+	if want := `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`; err != nil || string(got) != want {
+		t.Errorf("MarshalJSON() = %s, %v, want %s", got, err, want)
+	}
+}
+
 func TestScanString(t *testing.T) {
 	var u UUID
 	err := u.Scan("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
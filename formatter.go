@@ -0,0 +1,132 @@
+package uuid
+
+// Formatter describes a textual UUID representation for services that
+// mandate a non-canonical form. The zero Formatter is the canonical
+// lower-case hyphenated form produced by [UUID.String].
+//
+//	f := uuid.Formatter{Upper: true, Braces: true}
+//	f.Format(id)                 // "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"
+//	id, err := uuid.ParseWith(f, s)
+type Formatter struct {
+	Upper     bool // upper-case hex digits
+	NoHyphens bool // omit the four hyphens
+	Braces    bool // enclose in curly braces
+	URN       bool // prefix with "urn:uuid:" (outside any braces)
+}
+
+// Format returns u in the representation described by f.
+func (f Formatter) Format(u UUID) string {
+	var buf [47]byte
+	return string(f.Append(buf[:0], u))
+}
+
+// Append appends u in the representation described by f to b.
+func (f Formatter) Append(b []byte, u UUID) []byte {
+	if f.URN {
+		b = append(b, "urn:uuid:"...)
+	}
+	if f.Braces {
+		b = append(b, '{')
+	}
+	start := len(b)
+	if f.NoHyphens {
+		b = grow(b, 32)
+		encodeCompact(b[start:], u)
+	} else {
+		b = grow(b, 36)
+		encodeHex(b[start:], u)
+	}
+	if f.Upper {
+		for i := start; i < len(b); i++ {
+			if b[i] >= 'a' {
+				b[i] -= 'a' - 'A'
+			}
+		}
+	}
+	if f.Braces {
+		b = append(b, '}')
+	}
+	return b
+}
+
+// Style names one of the common UUID representations for
+// [UUID.AppendFormat]. Each is a preset [Formatter]. Values other than the
+// Style constants below format as [StyleCanonical].
+type Style uint8
+
+// Representation styles.
+const (
+	StyleCanonical Style = iota // 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	StyleUpper                  // 6BA7B810-9DAD-11D1-80B4-00C04FD430C8
+	StyleCompact                // 6ba7b8109dad11d180b400c04fd430c8
+	StyleBraced                 // {6ba7b810-9dad-11d1-80b4-00c04fd430c8}
+	StyleURN                    // urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8
+)
+
+// styleFormatters maps each Style to its Formatter.
+var styleFormatters = [...]Formatter{
+	StyleCanonical: {},
+	StyleUpper:     {Upper: true},
+	StyleCompact:   {NoHyphens: true},
+	StyleBraced:    {Braces: true},
+	StyleURN:       {URN: true},
+}
+
+// AppendFormat appends u in the given style to b, so log encoders and wire
+// writers can emit any accepted form without building a string. It does
+// not allocate if b has sufficient capacity. AppendFormat has no error
+// result, so an unknown style, such as Style(42), appends the canonical
+// form; use a [Formatter] when the representation comes from
+// configuration and must be validated.
+func (u UUID) AppendFormat(b []byte, style Style) []byte {
+	var f Formatter
+	if int(style) < len(styleFormatters) {
+		f = styleFormatters[style]
+	}
+	return f.Append(b, u)
+}
+
+// len returns the length of the representation described by f.
+func (f Formatter) len() int {
+	n := 36
+	if f.NoHyphens {
+		n = 32
+	}
+	if f.Braces {
+		n += 2
+	}
+	if f.URN {
+		n += 9
+	}
+	return n
+}
+
+// ParseWith parses s in exactly the representation described by f: the URN
+// prefix, braces, and hyphens must be present or absent as configured. Hex
+// digits are accepted in either case.
+func ParseWith(f Formatter, s string) (UUID, error) {
+	if len(s) != f.len() {
+		return Nil, &ParseError{Input: s, Msg: "unexpected length for format"}
+	}
+	offset := 0
+	if f.URN {
+		if s[:9] != "urn:uuid:" {
+			return Nil, &ParseError{Input: s, Msg: "expected urn:uuid: prefix"}
+		}
+		offset = 9
+	}
+	if f.Braces {
+		if s[offset] != '{' || s[len(s)-1] != '}' {
+			return Nil, &ParseError{Input: s, Msg: "expected braces"}
+		}
+		offset++
+	}
+	if !f.NoHyphens {
+		return parseHex(s, offset)
+	}
+	u, err := parseCompact(s[offset : offset+32])
+	if err != nil {
+		return Nil, &ParseError{Input: s, Msg: "invalid hex character"}
+	}
+	return u, nil
+}
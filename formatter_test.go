@@ -0,0 +1,99 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormatter(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		name string
+		f    Formatter
+		want string
+	}{
+		{"zero", Formatter{}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"upper", Formatter{Upper: true}, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{"compact", Formatter{NoHyphens: true}, "6ba7b8109dad11d180b400c04fd430c8"},
+		{"braces", Formatter{Braces: true}, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{"urn upper", Formatter{URN: true, Upper: true}, "urn:uuid:6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{"all", Formatter{Upper: true, NoHyphens: true, Braces: true, URN: true}, "urn:uuid:{6BA7B8109DAD11D180B400C04FD430C8}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Format(u); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+			if got := tt.f.Append([]byte("id="), u); string(got) != "id="+tt.want {
+				t.Errorf("Append() = %q, want %q", got, "id="+tt.want)
+			}
+			got, err := ParseWith(tt.f, tt.want)
+			if err != nil || got != u {
+				t.Errorf("ParseWith(%q) = %s, %v, want %s", tt.want, got, err, u)
+			}
+		})
+	}
+}
+
+func TestParseWithErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Formatter
+		s    string
+	}{
+		{"canonical given to compact", Formatter{NoHyphens: true}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"missing braces", Formatter{Braces: true}, "(6ba7b810-9dad-11d1-80b4-00c04fd430c8)"},
+		{"wrong prefix", Formatter{URN: true}, "urn:uid:-6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"misplaced hyphen", Formatter{}, "6ba7b8109-dad-11d1-80b4-00c04fd430c8"},
+		{"bad hex compact", Formatter{NoHyphens: true, Braces: true}, "{6ba7b8109dad11d180b400c04fd430cg}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWith(tt.f, tt.s)
+			perr, ok := errors.AsType[*ParseError](err)
+			if !ok {
+				t.Fatalf("ParseWith(%q) error = %v, want *ParseError", tt.s, err)
+			}
+			if perr.Input != tt.s {
+				t.Errorf("ParseError.Input = %q, want %q", perr.Input, tt.s)
+			}
+		})
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		style Style
+		want  string
+	}{
+		{StyleCanonical, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{StyleUpper, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{StyleCompact, "6ba7b8109dad11d180b400c04fd430c8"},
+		{StyleBraced, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{StyleURN, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	}
+	for _, tt := range tests {
+		if got := u.AppendFormat([]byte("id="), tt.style); string(got) != "id="+tt.want {
+			t.Errorf("AppendFormat(%d) = %q, want %q", tt.style, got, "id="+tt.want)
+		}
+	}
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = u.AppendFormat(buf[:0], StyleURN)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat allocated %v times, want 0", allocs)
+	}
+}
+
+func TestAppendFormatUnknownStyle(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	want := "id=" + u.String()
+	for _, style := range []Style{StyleURN + 1, 42, 255} {
+		if got := u.AppendFormat([]byte("id="), style); string(got) != want {
+			t.Errorf("AppendFormat(%d) = %q, want canonical %q", style, got, want)
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package uuid
 
 import (
-	"crypto/rand"
+	"context"
+	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
 	"hash"
+	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -22,6 +28,7 @@ func init() {
 	sha1URL = initHash(sha1.New(), NamespaceURL)
 	sha1OID = initHash(sha1.New(), NamespaceOID)
 	sha1X500 = initHash(sha1.New(), NamespaceX500)
+	defaultGen.Store(NewGenerator())
 }
 
 func initHash(h hash.Hash, ns UUID) hash.Cloner {
@@ -30,20 +37,207 @@ func initHash(h hash.Hash, ns UUID) hash.Cloner {
 }
 
 // NewV4 returns a new random (Version 4) UUID.
-// It reads from crypto/rand which cannot fail on Go 1.26+.
+// It reads from crypto/rand, which cannot fail with the standard Go
+// toolchain on Go 1.26+. TinyGo builds on targets without a random number
+// generator read from the [SetEntropyFallback] reader instead, and panic if
+// none is registered.
 func NewV4() UUID {
 	var u UUID
-	_, _ = rand.Read(u[:])
+	_, _ = randRead(u[:])
 	u[6] = (u[6] & 0x0f) | 0x40 // version 4
 	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
+	issued(u, V4)
 	return u
 }
 
+// NewV4String returns the standard 36-character hyphenated form of a new
+// random (Version 4) UUID, with a single allocation.
+func NewV4String() string {
+	return NewV4().String()
+}
+
+// NewV4FromReader returns a new random (Version 4) UUID with its random bits
+// read from r, such as a mandated DRBG or a seeded reader for deterministic
+// replay in simulations. It returns an error if r fails to supply 16 bytes.
+// To use r for every UUID of a Generator or Pool, see [WithRandReader].
+func NewV4FromReader(r io.Reader) (UUID, error) {
+	var u UUID
+	if _, err := io.ReadFull(r, u[:]); err != nil {
+		return Nil, fmt.Errorf("uuid: reading random bytes: %w", err)
+	}
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
+	issued(u, V4)
+	return u, nil
+}
+
 // NewV5 returns a deterministic Version 5 (SHA-1) UUID for the given namespace and name.
 func NewV5(namespace UUID, name string) UUID {
-	var h hash.Hash
+	h := v5Hash(namespace)
+	h.Write([]byte(name))
+	return hashSum(h, V5)
+}
+
+// NewV5Bytes is like [NewV5] but takes the name as a byte slice, avoiding a
+// conversion when the name is already []byte.
+func NewV5Bytes(namespace UUID, name []byte) UUID {
+	h := v5Hash(namespace)
+	h.Write(name)
+	return hashSum(h, V5)
+}
+
+// NewV5Reader is like [NewV5] but hashes the name incrementally as it is read
+// from r, for large or streamed names such as documents. It returns an error
+// if reading r fails.
+func NewV5Reader(namespace UUID, r io.Reader) (UUID, error) {
+	h := v5Hash(namespace)
+	if _, err := io.Copy(h, r); err != nil {
+		return Nil, fmt.Errorf("uuid: reading name: %w", err)
+	}
+	return hashSum(h, V5), nil
+}
+
+// NewV5Parts returns a deterministic Version 5 UUID for a composite name,
+// such as (tenant, entity, version). Each part is hashed with its length as
+// a uvarint prefix, so distinct part lists never collide the way plain
+// concatenation does ("ab"+"c" vs "a"+"bc"). The result equals [NewV5] of
+// the encoded name, uvarint(len(p0)) || p0 || uvarint(len(p1)) || p1 ...,
+// which other implementations can reproduce.
+func NewV5Parts(namespace UUID, parts ...string) UUID {
+	h := v5Hash(namespace)
+	var n [binary.MaxVarintLen64]byte
+	for _, p := range parts {
+		h.Write(n[:binary.PutUvarint(n[:], uint64(len(p)))])
+		h.Write([]byte(p))
+	}
+	return hashSum(h, V5)
+}
+
+// NewV8Name returns a deterministic name-based Version 8 UUID for the given
+// namespace and name, hashed with SHA-256 and truncated to 128 bits as in
+// RFC 9562 Appendix B.2. Use it instead of [NewV5] where SHA-1 is not
+// acceptable; the two produce different UUIDs for the same input.
+func NewV8Name(namespace UUID, name string) UUID {
+	h := sha256.New()
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+	return hashSum(h, V8)
+}
+
+// NewHashUUID returns a deterministic name-based UUID of the given version
+// for namespace and name, hashed with a new h and truncated to 128 bits, for
+// teams standardized on a hash such as SHA-512 or BLAKE2:
+//
+//	id := uuid.NewHashUUID(sha512.New, ns, "order-42", uuid.V8)
+//
+// NewHashUUID(sha1.New, ns, name, V5) equals [NewV5] and
+// NewHashUUID(sha256.New, ns, name, V8) equals [NewV8Name]. It panics if
+// version exceeds 15 or h produces digests shorter than 16 bytes.
+func NewHashUUID(h func() hash.Hash, namespace UUID, name string, version Version) UUID {
+	if version > 15 {
+		panic("uuid: version out of range")
+	}
+	hh := h()
+	if hh.Size() < 16 {
+		panic("uuid: hash digest shorter than 16 bytes")
+	}
+	hh.Write(namespace[:])
+	hh.Write([]byte(name))
+	return hashSum(hh, version)
+}
+
+// NewKeyed returns a deterministic Version 8 UUID for name, derived with
+// HMAC-SHA-256 under key and truncated to 128 bits. Unlike [NewV5], whose
+// result anyone can recompute from a guessed name, it cannot be predicted or
+// confirmed by brute-forcing names without the key, so it suits IDs derived
+// from PII such as email addresses. Rotating the key changes every ID.
+func NewKeyed(key []byte, name string) UUID {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(name))
+	return hashSum(mac, V8)
+}
+
+// DeriveUUID derives a stable Version 8 UUID from a master secret and
+// context info, such as a tenant ID or purpose label, with HKDF-SHA-256
+// (RFC 5869) and no salt. One root key can thus yield independent
+// per-tenant or per-purpose identifiers. The result is
+// hkdf.Key(sha256.New, secret, nil, string(info), 16) from [crypto/hkdf]
+// with the version and variant bits set.
+func DeriveUUID(secret, info []byte) UUID {
+	// HKDF-Extract with an all-zero salt, then the first HKDF-Expand block.
+	extract := hmac.New(sha256.New, make([]byte, sha256.Size))
+	extract.Write(secret)
+	expand := hmac.New(sha256.New, extract.Sum(nil))
+	expand.Write(info)
+	expand.Write([]byte{1})
+	return hashSum(expand, V8)
+}
+
+// DeriveNamespace folds path into nested V5 namespaces: each label is hashed
+// with [NewV5] under the namespace derived so far, starting from root. It
+// expresses hierarchical deterministic ID schemes declaratively:
+//
+//	project := uuid.DeriveNamespace(root, "acme", "billing") // org → project
+//	id := uuid.NewV5(project, "invoice-42")
+//
+// Derived namespaces are cached (up to a fixed number of entries), so
+// repeated derivations of shared prefixes are cheap.
+func DeriveNamespace(root UUID, path ...string) UUID {
+	ns := root
+	for _, label := range path {
+		key := nsKey{ns, label}
+		if v, ok := nsCache.Load(key); ok {
+			ns = v.(UUID)
+			continue
+		}
+		ns = NewV5(ns, label)
+		if nsCacheLen.Load() < nsCacheMax {
+			if _, loaded := nsCache.LoadOrStore(key, ns); !loaded {
+				nsCacheLen.Add(1)
+			}
+		}
+	}
+	return ns
+}
+
+// nsKey identifies a derivation step of [DeriveNamespace].
+type nsKey struct {
+	parent UUID
+	label  string
+}
+
+const nsCacheMax = 4096
+
+var (
+	nsCache    sync.Map // nsKey → UUID
+	nsCacheLen atomic.Int64
+)
+
+// NameHasher generates Version 5 UUIDs under one namespace from a SHA-1
+// state with the namespace already written, as the package does internally
+// for the standard namespaces. Create one with [NewV5Hasher] when generating
+// many UUIDs under a custom namespace. It is safe for concurrent use.
+type NameHasher struct {
+	seed hash.Cloner
+}
+
+// NewV5Hasher returns a [NameHasher] for namespace.
+func NewV5Hasher(namespace UUID) *NameHasher {
+	return &NameHasher{seed: initHash(sha1.New(), namespace)}
+}
 
-	// Use pre-cloned hash state for standard namespaces
+// Sum returns the Version 5 UUID of name, equal to [NewV5] of the hasher's
+// namespace and name.
+func (n *NameHasher) Sum(name string) UUID {
+	h, _ := n.seed.Clone()
+	h.Write([]byte(name))
+	return hashSum(h, V5)
+}
+
+// v5Hash returns a SHA-1 state with namespace already written, cloned from
+// a pre-initialized state for the standard namespaces.
+func v5Hash(namespace UUID) hash.Hash {
+	var h hash.Hash
 	switch namespace {
 	case NamespaceDNS:
 		c, _ := sha1DNS.Clone()
@@ -61,55 +255,122 @@ func NewV5(namespace UUID, name string) UUID {
 		h = sha1.New()
 		h.Write(namespace[:])
 	}
+	return h
+}
 
-	h.Write([]byte(name))
+// hashSum stamps the first 16 bytes of the digest in h as a UUID of
+// version v.
+func hashSum(h hash.Hash, v Version) UUID {
 	sum := h.Sum(nil)
 
 	var u UUID
 	copy(u[:], sum[:16])
-	u[6] = (u[6] & 0x0f) | 0x50 // version 5
-	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
+	u[6] = (u[6] & 0x0f) | byte(v)<<4 // version
+	u[8] = (u[8] & 0x3f) | 0x80       // variant RFC 9562
 	return u
 }
 
 // NewV4Batch returns n random (Version 4) UUIDs.
-// It amortizes the cost of crypto/rand by reading all random bytes in a
-// single call, making it significantly faster than calling [NewV4] in a loop.
+// It amortizes the cost of crypto/rand by reading random bytes in bulk,
+// making it significantly faster than calling [NewV4] in a loop.
 func NewV4Batch(n int) []UUID {
 	uuids := make([]UUID, n)
-	buf := make([]byte, n*16)
-	_, _ = rand.Read(buf)
-	for i := range n {
-		copy(uuids[i][:], buf[i*16:])
-		uuids[i][6] = (uuids[i][6] & 0x0f) | 0x40 // version 4
-		uuids[i][8] = (uuids[i][8] & 0x3f) | 0x80 // variant RFC 9562
-	}
+	FillV4(uuids)
 	return uuids
 }
 
+// NewV4BatchContext is like [NewV4Batch] but generates in chunks and checks
+// ctx before each one. If ctx is done, it returns the UUIDs generated so far
+// with ctx.Err(), so very large pre-allocation jobs can be aborted.
+func NewV4BatchContext(ctx context.Context, n int) ([]UUID, error) {
+	return batchContext(ctx, n, func(dst []UUID) error {
+		FillV4(dst)
+		return nil
+	})
+}
+
+// FillV4 fills dst with random (Version 4) UUIDs. It is like [NewV4Batch]
+// but writes into a caller-provided slice, so pipelines that reuse buffers
+// generate without allocating.
+func FillV4(dst []UUID) {
+	fillV4(dst)
+	for _, u := range dst {
+		issued(u, V4)
+	}
+}
+
+// fillV4 fills dst like [FillV4] without reporting the UUIDs to the
+// package-level hook.
+func fillV4(dst []UUID) {
+	buf := scratchPool.Get().(*[scratchSize]byte)
+	defer scratchPool.Put(buf)
+	for rest := dst; len(rest) > 0; {
+		chunk := rest[:min(len(rest), scratchSize/16)]
+		_, _ = randRead(buf[:len(chunk)*16])
+		for i := range chunk {
+			copy(chunk[i][:], buf[i*16:])
+			chunk[i][6] = (chunk[i][6] & 0x0f) | 0x40 // version 4
+			chunk[i][8] = (chunk[i][8] & 0x3f) | 0x80 // variant RFC 9562
+		}
+		rest = rest[len(chunk):]
+	}
+}
+
+// scratchSize is the size of the entropy buffers the Fill functions read
+// into, one chunk of UUIDs at a time.
+const scratchSize = 4096
+
+// scratchPool recycles entropy buffers, so filling a caller-provided slice
+// does not allocate.
+var scratchPool = sync.Pool{New: func() any { return new([scratchSize]byte) }}
+
+// batchChunk is the number of UUIDs generated between context checks.
+const batchChunk = 4096
+
+// batchContext generates n UUIDs with fill in chunks of batchChunk,
+// returning early with ctx.Err() once ctx is done.
+func batchContext(ctx context.Context, n int, fill func([]UUID) error) ([]UUID, error) {
+	uuids := make([]UUID, 0, n)
+	for len(uuids) < n {
+		if err := ctx.Err(); err != nil {
+			return uuids, err
+		}
+		end := min(n, len(uuids)+batchChunk)
+		if err := fill(uuids[len(uuids):end]); err != nil {
+			return uuids, err
+		}
+		uuids = uuids[:end]
+	}
+	return uuids, nil
+}
+
 // Pool amortizes the cost of crypto/rand by pre-generating random bytes
 // in bulk. It provides high-throughput [Pool.NewV4] and [Pool.NewV7] methods
 // that are functionally equivalent to the package-level functions.
 // Multiple goroutines may safely call methods concurrently.
 type Pool struct {
-	mu sync.Mutex
+	mu   sync.Mutex
+	opts options
 
 	// V4: fully pre-stamped UUIDs ready to hand out.
 	v4buf [poolSize]UUID
 	v4pos int
 
-	// V7: pre-generated random bytes for rand_b (bytes 8–15).
+	// V7: pre-generated random bytes for rand_b (bytes 8–15), and for
+	// rand_a (bytes 6–7) when a coarse precision is configured.
 	// Timestamp + monotonic sequence are computed live per call.
-	v7rand [poolSize * 8]byte
+	v7rand [poolSize * 10]byte
 	v7pos  int
-	v7seq  int64 // ms<<12 | seq for V7 monotonicity
+	v7     v7State
 }
 
 const poolSize = 256
 
 // NewPool returns a new [Pool] that amortizes crypto/rand overhead.
-func NewPool() *Pool {
+// Options such as [WithEntropyHook] customize its behavior.
+func NewPool(opts ...Option) *Pool {
 	return &Pool{
+		opts:  newOptions(opts),
 		v4pos: poolSize, // trigger refill on first V4 call
 		v7pos: poolSize, // trigger refill on first V7 call
 	}
@@ -117,74 +378,96 @@ func NewPool() *Pool {
 
 func (p *Pool) refillV4() {
 	var raw [poolSize * 16]byte
-	_, _ = rand.Read(raw[:])
+	p.opts.readRandom(raw[:])
 	for i := range poolSize {
 		copy(p.v4buf[i][:], raw[i*16:])
 		p.v4buf[i][6] = (p.v4buf[i][6] & 0x0f) | 0x40 // version 4
 		p.v4buf[i][8] = (p.v4buf[i][8] & 0x3f) | 0x80 // variant RFC 9562
 	}
 	p.v4pos = 0
+	p.opts.stats.refills.Add(1)
+	if m := p.opts.metrics; m != nil {
+		m.Refilled(V4)
+	}
 }
 
 func (p *Pool) refillV7() {
-	_, _ = rand.Read(p.v7rand[:])
+	p.opts.readRandom(p.v7rand[:poolSize*p.opts.v7RandLen()])
 	p.v7pos = 0
+	p.opts.stats.refills.Add(1)
+	if m := p.opts.metrics; m != nil {
+		m.Refilled(V7)
+	}
 }
 
 // NewV4 returns a new random (Version 4) UUID from the pool.
 // It is functionally equivalent to the package-level [NewV4] but
 // amortizes the crypto/rand overhead across pool refills.
 func (p *Pool) NewV4() UUID {
-	p.mu.Lock()
+	p.opts.stats.lock(&p.mu)
 	if p.v4pos >= poolSize {
 		p.refillV4()
 	}
 	u := p.v4buf[p.v4pos]
 	p.v4pos++
 	p.mu.Unlock()
+	p.opts.issued(u, V4)
 	return u
 }
 
+// NewV4String is like [Pool.NewV4] but returns the standard 36-character
+// hyphenated form, with a single allocation.
+func (p *Pool) NewV4String() string {
+	return p.NewV4().String()
+}
+
 // NewV7 returns a new Version 7 UUID from the pool.
 // It is functionally equivalent to [Generator.NewV7] but amortizes
 // the crypto/rand overhead by buffering random bytes for the rand_b field.
 // Timestamps are computed live to remain accurate.
+//
+// NewV7 panics with a [*RollbackError] if the clock has moved backwards
+// under [RollbackFail]; use [Pool.TryNewV7] to handle it.
 func (p *Pool) NewV7() UUID {
-	p.mu.Lock()
+	u, err := p.TryNewV7()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// TryNewV7 is like [Pool.NewV7] but returns a [*RollbackError] instead of
+// panicking if the clock has moved backwards under [RollbackFail]. With the
+// other policies it never fails.
+func (p *Pool) TryNewV7() (UUID, error) {
+	p.opts.stats.lock(&p.mu)
 	if p.v7pos >= poolSize {
 		p.refillV7()
 	}
 
 	var u UUID
-	off := p.v7pos * 8
-	copy(u[8:], p.v7rand[off:off+8])
+	n := p.opts.v7RandLen()
+	off := p.v7pos * n
+	copy(u[16-n:], p.v7rand[off:off+n])
 	p.v7pos++
 
-	now := time.Now()
-	nano := now.UnixNano()
-	ms := nano / nanoPerMilli
-	frac := (nano % nanoPerMilli) * 4096 / nanoPerMilli
-	seq := ms<<12 | frac
-
-	if seq <= p.v7seq {
-		seq = p.v7seq + 1
+	nano, err := p.v7.observe(&p.opts, p.opts.nowNano())
+	if err != nil {
+		p.mu.Unlock()
+		return Nil, err
 	}
-	p.v7seq = seq
+	seq, ext := p.v7.next(&p.opts, nano, u[6:])
 	p.mu.Unlock()
 
-	ms = seq >> 12
-	seq12 := seq & 0xFFF
+	p.opts.putV7(&u, seq, ext)
+	p.opts.issued(u, V7)
+	return u, nil
+}
 
-	u[0] = byte(ms >> 40)
-	u[1] = byte(ms >> 32)
-	u[2] = byte(ms >> 24)
-	u[3] = byte(ms >> 16)
-	u[4] = byte(ms >> 8)
-	u[5] = byte(ms)
-	u[6] = 0x70 | byte(seq12>>8)&0x0f
-	u[7] = byte(seq12)
-	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
-	return u
+// NewV7String is like [Pool.NewV7] but returns the standard 36-character
+// hyphenated form, with a single allocation.
+func (p *Pool) NewV7String() string {
+	return p.NewV7().String()
 }
 
 // NewV8 returns a Version 8 UUID constructed from user-provided data.
@@ -197,26 +480,99 @@ func NewV8(data [16]byte) UUID {
 	return u
 }
 
-// defaultGen is the package-level V7 generator, analogous to http.DefaultClient.
-var defaultGen = NewGenerator()
+// defaultGen holds the package-level V7, V1, V2, and V6 generator, analogous to http.DefaultClient.
+var defaultGen atomic.Pointer[Generator]
+
+// SetDefaultGenerator makes g the generator used by the package-level
+// [NewV7], [NewV1], [NewV2], and [NewV6] functions, so an application can
+// install one with a custom clock, entropy source, or metrics at startup and
+// have every call site pick it up. Passing nil restores a fresh default
+// generator. Monotonicity is per generator: UUIDs from g are not ordered
+// relative to those issued by the generator it replaces.
+func SetDefaultGenerator(g *Generator) {
+	if g == nil {
+		g = NewGenerator()
+	}
+	defaultGen.Store(g)
+}
 
 // NewV7 returns a new Version 7 (Unix timestamp + random) UUID using the
-// package-level default generator. For isolated monotonicity guarantees,
-// create a dedicated [Generator] with [NewGenerator].
+// package-level default generator (see [SetDefaultGenerator]). For isolated
+// monotonicity guarantees, create a dedicated [Generator] with [NewGenerator].
 func NewV7() UUID {
-	return defaultGen.NewV7()
+	u := defaultGen.Load().NewV7()
+	issued(u, V7)
+	return u
 }
 
-// Generator produces Version 7 UUIDs with per-instance monotonicity.
-// Multiple goroutines may safely call NewV7 concurrently on the same Generator.
+// NewV7FromReader returns a new Version 7 UUID from the package-level
+// default generator with its random bits read from r, such as an audited
+// DRBG. See [Generator.NewV7FromReader].
+func NewV7FromReader(r io.Reader) (UUID, error) {
+	u, err := defaultGen.Load().NewV7FromReader(r)
+	if err != nil {
+		return Nil, err
+	}
+	issued(u, V7)
+	return u, nil
+}
+
+// NewV7String returns the standard 36-character hyphenated form of a new
+// Version 7 UUID from the package-level default generator, with a single
+// allocation.
+func NewV7String() string {
+	return NewV7().String()
+}
+
+// Generator produces Version 4, 7, 1, 2, and 6 UUIDs, with per-instance
+// monotonicity for the time-based versions. Multiple goroutines may safely
+// call its methods concurrently.
 type Generator struct {
-	mu      sync.Mutex
-	lastSeq int64 // ms<<12 | seq for monotonicity
+	mu   sync.Mutex
+	v7   v7State
+	greg gregorianState
+	opts options
 }
 
 // NewGenerator returns a new V7 UUID generator with its own monotonicity state.
-func NewGenerator() *Generator {
-	return &Generator{}
+// Options such as [WithEntropyHook] customize its behavior.
+func NewGenerator(opts ...Option) *Generator {
+	return &Generator{opts: newOptions(opts)}
+}
+
+// V7Source is implemented by [*Generator] and [*Pool]. Code that only mints
+// V7 UUIDs can accept a V7Source, and tests can pass a fake.
+type V7Source interface {
+	NewV7() UUID
+}
+
+// Source is implemented by [*Generator] and [*Pool], so application code can
+// accept either, and tests can supply a fake without wrapping:
+//
+//	type Service struct {
+//	    IDs uuid.Source
+//	}
+type Source interface {
+	NewV4() UUID
+	NewV7() UUID
+}
+
+// NewV4 returns a new random (Version 4) UUID from the generator's entropy
+// source, reported to its hooks. It lets a Generator configured with
+// [WithRandReader] or [WithMetrics] serve as a [Source].
+func (g *Generator) NewV4() UUID {
+	var u UUID
+	g.opts.readRandom(u[:])
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
+	g.opts.issued(u, V4)
+	return u
+}
+
+// NewV4String is like [Generator.NewV4] but returns the standard
+// 36-character hyphenated form, with a single allocation.
+func (g *Generator) NewV4String() string {
+	return g.NewV4().String()
 }
 
 const nanoPerMilli = 1_000_000
@@ -231,84 +587,276 @@ const nanoPerMilli = 1_000_000
 // When multiple UUIDs are generated faster than the clock resolution,
 // the combined timestamp+seq counter is incremented to guarantee
 // monotonicity within this Generator.
+//
+// With [WithV7Precision], the timestamp is truncated instead and rand_a is
+// random; the timestamp never moves backwards, but UUIDs within the same
+// interval are not ordered. [WithV7Method] selects a counter in rand_a
+// instead of the sub-millisecond fraction.
+//
+// NewV7 panics with a [*RollbackError] if the clock has moved backwards
+// under [RollbackFail]; use [Generator.TryNewV7] to handle it.
 func (g *Generator) NewV7() UUID {
+	u, err := g.TryNewV7()
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// TryNewV7 is like [Generator.NewV7] but returns a [*RollbackError] instead
+// of panicking if the clock has moved backwards under [RollbackFail]. With
+// the other policies it never fails.
+func (g *Generator) TryNewV7() (UUID, error) {
 	var u UUID
-	_, _ = rand.Read(u[8:])
+	g.opts.readRandom(u[16-g.opts.v7RandLen():])
+	return g.stampV7(u)
+}
 
-	now := time.Now()
-	nano := now.UnixNano()
-	ms := nano / nanoPerMilli
-	// RFC 9562 Section 6.2 Method 3: sub-millisecond precision scaled to 12 bits.
-	frac := (nano % nanoPerMilli) * 4096 / nanoPerMilli
-	seq := ms<<12 | frac
+// NewV7FromReader is like [Generator.TryNewV7] but reads the random bits of
+// this UUID from r instead of the generator's entropy source, keeping the
+// generator's timestamp and monotonicity logic. It returns an error if r
+// fails to supply them.
+func (g *Generator) NewV7FromReader(r io.Reader) (UUID, error) {
+	var u UUID
+	if _, err := io.ReadFull(r, u[16-g.opts.v7RandLen():]); err != nil {
+		return Nil, fmt.Errorf("uuid: reading random bytes: %w", err)
+	}
+	return g.stampV7(u)
+}
 
-	g.mu.Lock()
-	if seq <= g.lastSeq {
-		seq = g.lastSeq + 1
+// stampV7 completes u, whose random bytes are already filled, with the
+// timestamp and sequence of the next V7 UUID.
+func (g *Generator) stampV7(u UUID) (UUID, error) {
+	g.opts.stats.lock(&g.mu)
+	nano, err := g.v7.observe(&g.opts, g.opts.nowNano())
+	if err != nil {
+		g.mu.Unlock()
+		return Nil, err
 	}
-	g.lastSeq = seq
+	seq, ext := g.v7.next(&g.opts, nano, u[6:])
 	g.mu.Unlock()
 
-	ms = seq >> 12
-	seq12 := seq & 0xFFF
-
-	// Encode 48-bit timestamp (big-endian) in bytes 0-5
-	u[0] = byte(ms >> 40)
-	u[1] = byte(ms >> 32)
-	u[2] = byte(ms >> 24)
-	u[3] = byte(ms >> 16)
-	u[4] = byte(ms >> 8)
-	u[5] = byte(ms)
-
-	// Encode version 7 and 12-bit sub-millisecond sequence in bytes 6-7
-	u[6] = 0x70 | byte(seq12>>8)&0x0f
-	u[7] = byte(seq12)
+	g.opts.putV7(&u, seq, ext)
+	g.opts.issued(u, V7)
+	return u, nil
+}
 
-	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
-	return u
+// NewV7String is like [Generator.NewV7] but returns the standard
+// 36-character hyphenated form, with a single allocation.
+func (g *Generator) NewV7String() string {
+	return g.NewV7().String()
 }
 
 // NewV7Batch returns n Version 7 UUIDs that are monotonically increasing.
-// It amortizes the cost of crypto/rand and [time.Now] by performing a single
-// call of each, making it significantly faster than calling [Generator.NewV7]
-// in a loop.
+// It amortizes the cost of crypto/rand and [time.Now] by reading random bytes
+// in bulk and the clock once, making it significantly faster than calling
+// [Generator.NewV7] in a loop. With [WithV7Precision], UUIDs share the
+// truncated timestamp and are not ordered within the batch.
 func (g *Generator) NewV7Batch(n int) []UUID {
 	uuids := make([]UUID, n)
+	g.FillV7(uuids)
+	return uuids
+}
 
-	// One bulk random read for all rand_b fields.
-	randBuf := make([]byte, n*8)
-	_, _ = rand.Read(randBuf)
+// NewV7BatchContext is like [Generator.NewV7Batch] but generates in chunks
+// and checks ctx before each one. If ctx is done, it returns the UUIDs
+// generated so far with ctx.Err(), so very large pre-allocation jobs can be
+// aborted during shutdown. UUIDs are monotonically increasing across chunks.
+// Under [RollbackFail], a clock rollback likewise ends the batch with a
+// [*RollbackError] instead of a panic.
+func (g *Generator) NewV7BatchContext(ctx context.Context, n int) ([]UUID, error) {
+	return batchContext(ctx, n, g.TryFillV7)
+}
 
-	now := time.Now()
-	nano := now.UnixNano()
-	ms := nano / nanoPerMilli
-	frac := (nano % nanoPerMilli) * 4096 / nanoPerMilli
-	seq := ms<<12 | frac
+// FillV7 fills dst with monotonically increasing Version 7 UUIDs. It is
+// like [Generator.NewV7Batch] but writes into a caller-provided slice, so
+// pipelines that reuse buffers generate without allocating.
+//
+// FillV7 panics with a [*RollbackError] if the clock has moved backwards
+// under [RollbackFail]; use [Generator.TryFillV7] to handle it.
+func (g *Generator) FillV7(dst []UUID) {
+	if err := g.TryFillV7(dst); err != nil {
+		panic(err)
+	}
+}
 
-	g.mu.Lock()
-	if seq <= g.lastSeq {
-		seq = g.lastSeq + 1
+// TryFillV7 is like [Generator.FillV7] but returns a [*RollbackError],
+// leaving dst unchanged, instead of panicking if the clock has moved
+// backwards under [RollbackFail]. With the other policies it never fails.
+func (g *Generator) TryFillV7(dst []UUID) error {
+	if err := g.fillV7(dst); err != nil {
+		return err
 	}
+	g.opts.issuedBatch(dst, V7)
+	return nil
+}
 
-	for i := range n {
-		s := seq + int64(i)
-		msI := s >> 12
-		seq12 := s & 0xFFF
+// fillV7 fills uuids from one clock reading, holding the lock throughout so
+// the batch is contiguous, and reading the rand_b (and, if coarse, rand_a)
+// fields in bulk one chunk at a time.
+func (g *Generator) fillV7(uuids []UUID) error {
+	buf := scratchPool.Get().(*[scratchSize]byte)
+	defer scratchPool.Put(buf)
+	stride := g.opts.v7RandLen()
+
+	g.opts.stats.lock(&g.mu)
+	defer g.mu.Unlock()
+	nano, err := g.v7.observe(&g.opts, g.opts.nowNano())
+	if err != nil {
+		return err
+	}
+	for rest := uuids; len(rest) > 0; {
+		chunk := rest[:min(len(rest), scratchSize/stride)]
+		g.opts.readRandom(buf[:len(chunk)*stride])
+		for i := range chunk {
+			u := &chunk[i]
+			copy(u[16-stride:], buf[i*stride:(i+1)*stride])
+			seq, ext := g.v7.next(&g.opts, nano, u[6:])
+			g.opts.putV7(u, seq, ext)
+		}
+		rest = rest[len(chunk):]
+	}
+	return nil
+}
 
-		copy(uuids[i][8:], randBuf[i*8:i*8+8])
+// v7State is the V7 monotonicity state of a Generator or Pool.
+// It is guarded by the owner's mutex.
+type v7State struct {
+	lastSeq  int64  // ms<<12 | rand_a of the last issued UUID
+	lastExt  uint64 // counter bits in rand_b of the last issued UUID
+	lastNano int64  // last clock reading, for rollback detection
+}
 
-		uuids[i][0] = byte(msI >> 40)
-		uuids[i][1] = byte(msI >> 32)
-		uuids[i][2] = byte(msI >> 24)
-		uuids[i][3] = byte(msI >> 16)
-		uuids[i][4] = byte(msI >> 8)
-		uuids[i][5] = byte(msI)
-		uuids[i][6] = 0x70 | byte(seq12>>8)&0x0f
-		uuids[i][7] = byte(seq12)
-		uuids[i][8] = (uuids[i][8] & 0x3f) | 0x80 // variant RFC 9562
+// observe records the clock reading nano, reporting a rollback and applying
+// the configured [RollbackPolicy] to it. It returns the reading to generate
+// at, which is later than nano after waiting.
+func (st *v7State) observe(o *options, nano int64) (int64, error) {
+	if nano < st.lastNano {
+		o.clockRollback(time.Duration(st.lastNano - nano))
+		switch o.rollback {
+		case RollbackWait:
+			for nano < st.lastNano {
+				time.Sleep(time.Duration(st.lastNano - nano))
+				nano = o.nowNano()
+			}
+		case RollbackFail:
+			return 0, &RollbackError{Rollback: time.Duration(st.lastNano - nano)}
+		}
 	}
-	g.lastSeq = seq + int64(n-1)
-	g.mu.Unlock()
+	st.lastNano = nano
+	return nano, nil
+}
 
-	return uuids
+// next returns the sequence and counter extension for a V7 UUID generated
+// at the Unix time nano, whose random bytes 6–15 are in rnd, and records
+// them as the last issued ones.
+func (st *v7State) next(o *options, nano int64, rnd []byte) (int64, uint64) {
+	raw := o.v7Seq(nano, rnd[:2])
+	seq, ext := o.v7Next(raw, o.v7Ext(rnd[2:]), st.lastSeq, st.lastExt)
+	if seq>>12 != raw>>12 || o.subMilli() && seq != raw {
+		o.stats.clamps.Add(1)
+	}
+	st.lastSeq, st.lastExt = seq, ext
+	return seq, ext
+}
+
+// subMilli reports whether rand_a carries the Method 3 sub-millisecond
+// fraction, rather than random bits or a randomly seeded counter.
+func (o *options) subMilli() bool {
+	return o.method == V7SubMillisecond && o.precision == 0
+}
+
+// v7RandLen returns how many random bytes a V7 UUID consumes: rand_b only,
+// or rand_a and rand_b with a coarse precision or a counter method.
+func (o *options) v7RandLen() int {
+	if o.subMilli() {
+		return 8
+	}
+	return 10
+}
+
+// v7Seq returns the V7 sequence ms<<12 | rand_a for the Unix time nano.
+// By default rand_a carries sub-millisecond precision per RFC 9562
+// Section 6.2 Method 3. With a coarse precision the timestamp is truncated.
+// Otherwise rand_a is taken from the random bytes in randA, with the
+// leftmost bit cleared for the counter methods to guard against rollover.
+func (o *options) v7Seq(nano int64, randA []byte) int64 {
+	ms := nano / nanoPerMilli
+	if o.subMilli() {
+		// RFC 9562 Section 6.2 Method 3: sub-millisecond precision scaled to 12 bits.
+		frac := (nano % nanoPerMilli) * 4096 / nanoPerMilli
+		return ms<<12 | frac
+	}
+	if o.precision > 0 {
+		ms -= ms % o.precision
+	}
+	mask := byte(0x0f)
+	if o.method != V7SubMillisecond {
+		mask = 0x07
+	}
+	return ms<<12 | int64(randA[0]&mask)<<8 | int64(randA[1])
+}
+
+// v7Ext returns the random counter extension bits of [WithV7CounterBits]
+// from the leftmost bits of rand_b, the 8 bytes in randB.
+func (o *options) v7Ext(randB []byte) uint64 {
+	if o.extBits == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(randB) << 2 >> (64 - o.extBits)
+}
+
+// v7Next makes seq and its counter extension ext monotonic with respect to
+// the last issued ones. By default the combined timestamp+seq+ext counter is
+// incremented; with a coarse precision only the timestamp is clamped so it
+// never moves backwards. The counter methods keep the randomly seeded seq
+// and ext when the timestamp advances and otherwise increment the last
+// counter, by one (Method 1) or by a random amount from 1 to 16 taken from
+// seq (Method 2).
+func (o *options) v7Next(seq int64, ext uint64, last int64, lastExt uint64) (int64, uint64) {
+	switch {
+	case o.subMilli():
+		if seq < last || seq == last && ext <= lastExt {
+			return o.v7Add(last, lastExt, 1)
+		}
+	case o.method == V7SubMillisecond:
+		if seq>>12 < last>>12 {
+			return last>>12<<12 | seq&0xFFF, ext
+		}
+	case seq>>12 <= last>>12:
+		step := uint64(1)
+		if o.method == V7RandomIncrement {
+			step += uint64(seq & 0x0f)
+		}
+		return o.v7Add(last, lastExt, step)
+	}
+	return seq, ext
+}
+
+// v7Add adds step to the counter formed by seq and its extension ext,
+// carrying from ext into seq and from rand_a into the timestamp.
+func (o *options) v7Add(seq int64, ext, step uint64) (int64, uint64) {
+	sum := ext + step
+	return seq + int64(sum>>o.extBits), sum & (1<<o.extBits - 1)
+}
+
+// putV7 encodes seq (ms<<12 | rand_a) into bytes 0–7 of u and sets the
+// version and variant bits. Bytes 8–15 must already hold rand_b.
+func putV7(u *UUID, seq int64) {
+	ms := seq >> 12
+	seq12 := seq & 0xFFF
+
+	// Encode 48-bit timestamp (big-endian) in bytes 0-5
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+
+	// Encode version 7 and 12-bit rand_a in bytes 6-7
+	u[6] = 0x70 | byte(seq12>>8)&0x0f
+	u[7] = byte(seq12)
+
+	u[8] = (u[8] & 0x3f) | 0x80 // variant RFC 9562
 }
@@ -1,9 +1,22 @@
 package uuid
 
 import (
+	"context"
+	"crypto/hkdf"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"math/rand/v2"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"testing/cryptotest"
+	"testing/iotest"
 	"testing/synctest"
 	"time"
 )
@@ -69,6 +82,23 @@ func TestNewV4Batch(t *testing.T) {
 	}
 }
 
+func TestNewV4FromReader(t *testing.T) {
+	seed := [32]byte{1}
+	a, err := NewV4FromReader(rand.NewChaCha8(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewV4FromReader(rand.NewChaCha8(seed))
+	if a != b || a.Version() != V4 || a.Variant() != VariantRFC9562 {
+		t.Errorf("NewV4FromReader() = %s, %s, want equal RFC 9562 V4 UUIDs", a, b)
+	}
+
+	_, err = NewV4FromReader(strings.NewReader("short"))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("NewV4FromReader(short) error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
 func TestNewV4BatchZero(t *testing.T) {
 	uuids := NewV4Batch(0)
 	if len(uuids) != 0 {
@@ -90,6 +120,25 @@ func TestNewV4BatchDeterministic(t *testing.T) {
 	}
 }
 
+func TestFillV4(t *testing.T) {
+	// Spans several entropy chunks.
+	dst := make([]UUID, 3*scratchSize/16+5)
+	FillV4(dst)
+	first := dst[len(dst)-1]
+	FillV4(dst)
+
+	seen := make(map[UUID]bool, len(dst))
+	for i, u := range dst {
+		if u.Version() != V4 || u.Variant() != VariantRFC9562 {
+			t.Fatalf("dst[%d] = %s, want RFC 9562 V4", i, u)
+		}
+		if seen[u] || u == first {
+			t.Fatalf("duplicate UUID at index %d: %s", i, u)
+		}
+		seen[u] = true
+	}
+}
+
 func TestPoolNewV4(t *testing.T) {
 	pool := NewPool()
 	seen := make(map[UUID]bool, 1000)
@@ -147,6 +196,61 @@ func TestPoolNewV7(t *testing.T) {
 	}
 }
 
+func TestStringVariantsAllocs(t *testing.T) {
+	gen := NewGenerator()
+	pool := NewPool()
+	tests := []struct {
+		name string
+		fn   func() string
+		want Version
+	}{
+		{"NewV4String", NewV4String, V4},
+		{"NewV7String", NewV7String, V7},
+		{"Generator.NewV4String", gen.NewV4String, V4},
+		{"Generator.NewV7String", gen.NewV7String, V7},
+		{"Pool.NewV4String", pool.NewV4String, V4},
+		{"Pool.NewV7String", pool.NewV7String, V7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := Parse(tt.fn())
+			if err != nil {
+				t.Fatalf("%s() is not canonical: %v", tt.name, err)
+			}
+			if u.Version() != tt.want {
+				t.Errorf("%s() version = %v, want %v", tt.name, u.Version(), tt.want)
+			}
+			if raceEnabled {
+				t.Skip("the race detector adds allocations")
+			}
+			if allocs := testing.AllocsPerRun(1000, func() { tt.fn() }); allocs != 1 {
+				t.Errorf("%s() allocs = %v, want 1", tt.name, allocs)
+			}
+		})
+	}
+}
+
+func TestGenerateAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector adds allocations")
+	}
+	gen := NewGenerator()
+	pool := NewPool()
+	for name, fn := range map[string]func() UUID{
+		"NewV4":           NewV4,
+		"NewV7":           NewV7,
+		"Generator.NewV4": gen.NewV4,
+		"Generator.NewV7": gen.NewV7,
+		"Pool.NewV4":      pool.NewV4,
+		"Pool.NewV7":      pool.NewV7,
+	} {
+		// Enough runs to include Pool refills.
+		if allocs := testing.AllocsPerRun(1000, func() { fn() }); allocs != 0 {
+			t.Errorf("%s() allocs = %v, want 0", name, allocs)
+		}
+	}
+}
+
 func TestPoolNewV7Monotonic(t *testing.T) {
 	pool := NewPool()
 	prev := pool.NewV7()
@@ -195,6 +299,79 @@ func TestNewV5(t *testing.T) {
 	}
 }
 
+func TestNewV5BytesAndReader(t *testing.T) {
+	want := NewV5(NamespaceURL, "https://example.com/doc")
+	if got := NewV5Bytes(NamespaceURL, []byte("https://example.com/doc")); got != want {
+		t.Errorf("NewV5Bytes() = %s, want %s", got, want)
+	}
+	got, err := NewV5Reader(NamespaceURL, iotest.OneByteReader(strings.NewReader("https://example.com/doc")))
+	if err != nil || got != want {
+		t.Errorf("NewV5Reader() = %s, %v, want %s", got, err, want)
+	}
+
+	errRead := errors.New("disk gone")
+	if _, err := NewV5Reader(NamespaceURL, iotest.ErrReader(errRead)); !errors.Is(err, errRead) {
+		t.Errorf("NewV5Reader(failing) error = %v, want %v", err, errRead)
+	}
+}
+
+func TestNameHasher(t *testing.T) {
+	ns := MustParse("0f0e0d0c-0b0a-4908-8706-050403020100")
+	h := NewV5Hasher(ns)
+	for _, name := range []string{"", "a", "order-42", "order-42"} {
+		if got, want := h.Sum(name), NewV5(ns, name); got != want {
+			t.Errorf("Sum(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestNewV5Parts(t *testing.T) {
+	got := NewV5Parts(NamespaceDNS, "ab", "c")
+	if got.Version() != V5 || got.Variant() != VariantRFC9562 {
+		t.Errorf("NewV5Parts() = %s, want V5 RFC9562", got)
+	}
+	if want := NewV5(NamespaceDNS, "\x02ab\x01c"); got != want {
+		t.Errorf("NewV5Parts(ab, c) = %s, want NewV5 of length-prefixed name %s", got, want)
+	}
+	if other := NewV5Parts(NamespaceDNS, "a", "bc"); got == other {
+		t.Errorf("NewV5Parts(ab, c) == NewV5Parts(a, bc) = %s", got)
+	}
+	if a, b := NewV5Parts(Max, "x"), NewV5Parts(Max, "x", ""); a == b {
+		t.Errorf("trailing empty part did not change the UUID: %s", a)
+	}
+	long := strings.Repeat("x", 300) // two-byte uvarint length
+	if got, want := NewV5Parts(NamespaceURL, long), NewV5(NamespaceURL, "\xac\x02"+long); got != want {
+		t.Errorf("NewV5Parts(long) = %s, want %s", got, want)
+	}
+}
+
+func TestDeriveNamespace(t *testing.T) {
+	want := NewV5(NewV5(NamespaceURL, "acme"), "billing")
+	for range 2 { // second round is served from the cache
+		if got := DeriveNamespace(NamespaceURL, "acme", "billing"); got != want {
+			t.Errorf("DeriveNamespace(acme, billing) = %s, want %s", got, want)
+		}
+	}
+	if got := DeriveNamespace(NamespaceURL); got != NamespaceURL {
+		t.Errorf("DeriveNamespace() = %s, want root", got)
+	}
+	if a, b := DeriveNamespace(NamespaceURL, "ab", "c"), DeriveNamespace(NamespaceURL, "a", "bc"); a == b {
+		t.Errorf("paths (ab, c) and (a, bc) collide: %s", a)
+	}
+}
+
+func TestDeriveNamespaceCacheBounded(t *testing.T) {
+	for i := range nsCacheMax + 10 {
+		DeriveNamespace(Max, strconv.Itoa(i))
+	}
+	if n := nsCacheLen.Load(); n > nsCacheMax {
+		t.Errorf("cache holds %d entries, want at most %d", n, nsCacheMax)
+	}
+	if got, want := DeriveNamespace(Max, strconv.Itoa(nsCacheMax+5)), NewV5(Max, strconv.Itoa(nsCacheMax+5)); got != want {
+		t.Errorf("uncached DeriveNamespace = %s, want %s", got, want)
+	}
+}
+
 func TestNewV5Deterministic(t *testing.T) {
 	a := NewV5(NamespaceURL, "https://example.com")
 	b := NewV5(NamespaceURL, "https://example.com")
@@ -260,6 +437,68 @@ func TestNewV8(t *testing.T) {
 	}
 }
 
+func TestNewV8Name(t *testing.T) {
+	// RFC 9562 Appendix B.2.
+	u := NewV8Name(NamespaceDNS, "www.example.com")
+	if want := "5c146b14-3c52-8afd-938a-375d0df1fbf6"; u.String() != want {
+		t.Errorf("NewV8Name() = %s, want %s", u, want)
+	}
+	if u == NewV8Name(NamespaceURL, "www.example.com") || u == NewV8Name(NamespaceDNS, "example.com") {
+		t.Error("NewV8Name() ignores its namespace or name")
+	}
+}
+
+func TestNewHashUUID(t *testing.T) {
+	ns := NamespaceDNS
+	if got, want := NewHashUUID(sha1.New, ns, "python.org", V5), NewV5(ns, "python.org"); got != want {
+		t.Errorf("NewHashUUID(sha1, V5) = %s, want NewV5 %s", got, want)
+	}
+	if got, want := NewHashUUID(sha256.New, ns, "www.example.com", V8), NewV8Name(ns, "www.example.com"); got != want {
+		t.Errorf("NewHashUUID(sha256, V8) = %s, want NewV8Name %s", got, want)
+	}
+	if u := NewHashUUID(sha512.New, ns, "order-42", V8); u.Version() != V8 || u.Variant() != VariantRFC9562 {
+		t.Errorf("NewHashUUID(sha512, V8) = %s, want RFC 9562 V8", u)
+	}
+
+	for name, f := range map[string]func(){
+		"version 16": func() { NewHashUUID(sha256.New, ns, "x", 16) },
+		"short hash": func() { NewHashUUID(func() hash.Hash { return crc32.NewIEEE() }, ns, "x", V8) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: NewHashUUID did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestNewKeyed(t *testing.T) {
+	u := NewKeyed([]byte("secret-key"), "alice@example.com")
+	if want := "e7c2c6e7-50de-87bc-bcf0-66c0fc31f518"; u.String() != want {
+		t.Errorf("NewKeyed() = %s, want %s", u, want)
+	}
+	if u == NewKeyed([]byte("other-key"), "alice@example.com") {
+		t.Error("NewKeyed() ignores its key")
+	}
+}
+
+func TestDeriveUUID(t *testing.T) {
+	secret := []byte("master secret")
+	for _, info := range []string{"", "tenant-1", "tenant-2/billing"} {
+		key, err := hkdf.Key(sha256.New, secret, nil, info, 16)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := NewV8([16]byte(key))
+		if got := DeriveUUID(secret, []byte(info)); got != want {
+			t.Errorf("DeriveUUID(%q) = %s, want %s", info, got, want)
+		}
+	}
+}
+
 func TestNewV8Deterministic(t *testing.T) {
 	var data [16]byte
 	data[0] = 0xab
@@ -473,6 +712,63 @@ func TestNewV7BatchMonotonicAcrossCalls(t *testing.T) {
 	}
 }
 
+func TestFillV7(t *testing.T) {
+	gen := NewGenerator(WithV7Precision(10 * time.Millisecond))
+	dst := make([]UUID, 3*scratchSize/10+5)
+	gen.FillV7(dst)
+	last := dst[len(dst)-1]
+	gen.FillV7(dst)
+
+	for i, u := range dst {
+		if u.Version() != V7 || u.Variant() != VariantRFC9562 {
+			t.Fatalf("dst[%d] = %s, want RFC 9562 V7", i, u)
+		}
+	}
+	if dst[0].Time().Before(last.Time()) {
+		t.Errorf("refilled dst[0] time %v before previous last %v", dst[0].Time(), last.Time())
+	}
+
+	gen = NewGenerator()
+	gen.FillV7(dst)
+	if !slices.IsSortedFunc(dst, Compare) {
+		t.Error("FillV7 UUIDs are not monotonically increasing")
+	}
+}
+
+func TestNewV7BatchContext(t *testing.T) {
+	gen := NewGenerator()
+	n := 2*batchChunk + 10
+	uuids, err := gen.NewV7BatchContext(t.Context(), n)
+	if err != nil || len(uuids) != n {
+		t.Fatalf("NewV7BatchContext(%d) = %d UUIDs, %v", n, len(uuids), err)
+	}
+	if !slices.IsSortedFunc(uuids, Compare) || uuids[0].Version() != V7 {
+		t.Error("NewV7BatchContext() UUIDs are not monotonic V7 UUIDs")
+	}
+	if uuids, err := gen.NewV7BatchContext(t.Context(), 0); err != nil || len(uuids) != 0 {
+		t.Errorf("NewV7BatchContext(0) = %v, %v", uuids, err)
+	}
+}
+
+func TestBatchContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	// Cancel after the first chunk has been generated.
+	gen := NewGenerator(WithGenerateHook(func(UUID, Version) { cancel() }))
+	uuids, err := gen.NewV7BatchContext(ctx, 3*batchChunk)
+	if !errors.Is(err, context.Canceled) || len(uuids) != batchChunk {
+		t.Errorf("NewV7BatchContext() = %d UUIDs, %v; want %d, context.Canceled", len(uuids), err, batchChunk)
+	}
+
+	uuids, err = NewV4BatchContext(ctx, 10)
+	if !errors.Is(err, context.Canceled) || len(uuids) != 0 {
+		t.Errorf("NewV4BatchContext(canceled) = %d UUIDs, %v", len(uuids), err)
+	}
+	uuids, err = NewV4BatchContext(t.Context(), batchChunk+1)
+	if err != nil || len(uuids) != batchChunk+1 || uuids[batchChunk].Version() != V4 {
+		t.Errorf("NewV4BatchContext() = %d UUIDs, %v", len(uuids), err)
+	}
+}
+
 func TestNewV7BatchMonotonicSameMillisecond(t *testing.T) {
 	synctest.Test(t, func(t *testing.T) {
 		gen := NewGenerator()
@@ -516,3 +812,73 @@ func TestNewV7BatchInterleavedWithSingle(t *testing.T) {
 		t.Errorf("single NewV7 should be > last batch UUID: %s <= %s", single, lastOfBatch)
 	}
 }
+
+func TestSetDefaultGenerator(t *testing.T) {
+	t.Cleanup(func() { SetDefaultGenerator(nil) })
+	synctest.Test(t, func(t *testing.T) {
+		fixed := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		gen := NewGenerator(WithClock(func() time.Time { return fixed }))
+		SetDefaultGenerator(gen)
+
+		if got := NewV7().Time(); !got.Equal(fixed) {
+			t.Errorf("NewV7().Time() = %v, want %v from the installed clock", got, fixed)
+		}
+		if got, _ := NewV6().TimeOK(); !got.Equal(fixed) {
+			t.Errorf("NewV6().TimeOK() = %v, want %v from the installed clock", got, fixed)
+		}
+		if s := gen.Stats(); s.Issued != 2 {
+			t.Errorf("installed generator issued %d UUIDs, want 2", s.Issued)
+		}
+
+		SetDefaultGenerator(nil)
+		if got := NewV7().Time(); got.Equal(fixed) {
+			t.Error("NewV7() still uses the replaced generator after SetDefaultGenerator(nil)")
+		}
+	})
+}
+
+var (
+	_ Source   = (*Generator)(nil)
+	_ Source   = (*Pool)(nil)
+	_ V7Source = (*Generator)(nil)
+	_ V7Source = (*Pool)(nil)
+)
+
+func TestGeneratorNewV4(t *testing.T) {
+	var rec hookRecorder
+	gen := NewGenerator(WithRandReader(rand.NewChaCha8([32]byte{})), WithGenerateHook(rec.hook))
+	a, b := gen.NewV4(), gen.NewV4()
+	if a.Version() != V4 || a.Variant() != VariantRFC9562 || a == b {
+		t.Errorf("NewV4() = %s, %s, want distinct RFC 9562 V4 UUIDs", a, b)
+	}
+	if want := NewGenerator(WithRandReader(rand.NewChaCha8([32]byte{}))).NewV4(); a != want {
+		t.Errorf("NewV4() = %s, want %s from the configured reader", a, want)
+	}
+	if len(rec.uuids) != 2 || rec.versions[0] != V4 {
+		t.Errorf("hook saw %v %v, want two V4 UUIDs", rec.uuids, rec.versions)
+	}
+}
+
+func TestNewV7FromReader(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator()
+		a := gen.NewV7()
+		b, err := gen.NewV7FromReader(strings.NewReader("\x00\x11\x22\x33\x44\x55\x66\x77"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if Compare(b, a) <= 0 || b.RandB() != 0x0011223344556677 {
+			t.Errorf("NewV7FromReader() = %s after %s, want increasing with rand_b from the reader", b, a)
+		}
+		if c := gen.NewV7(); Compare(c, b) <= 0 {
+			t.Errorf("NewV7() = %s after %s, want increasing", c, b)
+		}
+	})
+
+	if u, err := NewV7FromReader(strings.NewReader("01234567")); err != nil || u.Version() != V7 {
+		t.Errorf("NewV7FromReader() = %s, %v", u, err)
+	}
+	if _, err := NewV7FromReader(strings.NewReader("short")); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("NewV7FromReader(short) error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"fmt"
+	"io"
+)
+
+// MarshalGQL writes u as a quoted GraphQL string. Together with
+// [UUID.UnmarshalGQL] it implements gqlgen's Marshaler and Unmarshaler, so
+// a schema scalar maps onto UUID directly:
+//
+//	# schema.graphql
+//	scalar UUID
+//
+//	# gqlgen.yml
+//	models:
+//	  UUID:
+//	    model: github.com/pscheid92/uuid.UUID
+//
+// Nullable fields use *UUID, as with JSON.
+func (u UUID) MarshalGQL(w io.Writer) {
+	var buf [38]byte
+	_, _ = w.Write(u.AppendJSON(buf[:0]))
+}
+
+// UnmarshalGQL parses a GraphQL input value, which must be a string in the
+// strict 36-character form. It implements gqlgen's Unmarshaler.
+func (u *UUID) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("uuid: cannot unmarshal %T into UUID", v)
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
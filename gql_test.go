@@ -0,0 +1,31 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGQL(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	var sb strings.Builder
+	u.MarshalGQL(&sb)
+	if want := `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`; sb.String() != want {
+		t.Errorf("MarshalGQL() = %s, want %s", sb.String(), want)
+	}
+
+	var got UUID
+	if err := got.UnmarshalGQL(u.String()); err != nil || got != u {
+		t.Errorf("UnmarshalGQL() = %s, %v, want %s", got, err, u)
+	}
+	if err := got.UnmarshalGQL(42); err == nil {
+		t.Error("UnmarshalGQL(42) succeeded, want error")
+	}
+	err := got.UnmarshalGQL("not-a-uuid")
+	if _, ok := errors.AsType[*ParseError](err); !ok {
+		t.Errorf("UnmarshalGQL(invalid) error = %v, want *ParseError", err)
+	}
+	if got != u {
+		t.Errorf("failed UnmarshalGQL modified receiver: %s", got)
+	}
+}
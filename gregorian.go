@@ -0,0 +1,227 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+)
+
+// gregorianOffset is the number of 100 ns intervals between the Gregorian
+// epoch of V1 and V6 timestamps (1582-10-15) and the Unix epoch.
+const gregorianOffset = 0x01b21dd213814000
+
+// gregorianTicks returns the 60-bit timestamp of a V1 or V6 UUID in 100 ns
+// intervals since the Gregorian epoch.
+func gregorianTicks(u UUID) int64 {
+	if u.Version() == V6 {
+		return int64(u[0])<<52 | int64(u[1])<<44 | int64(u[2])<<36 | int64(u[3])<<28 |
+			int64(u[4])<<20 | int64(u[5])<<12 | int64(u[6]&0x0f)<<8 | int64(u[7])
+	}
+	return int64(u[6]&0x0f)<<56 | int64(u[7])<<48 | int64(u[4])<<40 | int64(u[5])<<32 |
+		int64(u[0])<<24 | int64(u[1])<<16 | int64(u[2])<<8 | int64(u[3])
+}
+
+// gregorianTime converts a Gregorian timestamp to a time.Time.
+func gregorianTime(ticks int64) time.Time {
+	ticks -= gregorianOffset
+	return time.Unix(ticks/10_000_000, ticks%10_000_000*100)
+}
+
+// NewV1 returns a new Version 1 (Gregorian time + node) UUID using the
+// package-level default generator. See [Generator.NewV1].
+func NewV1() UUID {
+	u := defaultGen.Load().NewV1()
+	issued(u, V1)
+	return u
+}
+
+// NewV1 returns a new Version 1 UUID: a 60-bit count of 100 ns intervals
+// since 1582-10-15, a 14-bit clock sequence, and a 48-bit node ID. V1 is
+// intended for interoperating with legacy systems; prefer V7 for new keys.
+//
+// The node ID is random, with the multicast bit set as RFC 9562 Section 6.10
+// requires, unless set with [WithNode]. The clock sequence is random per
+// Generator. Like V7, timestamps never repeat or move backwards within a
+// Generator: if the clock has not advanced since the last UUID, the
+// timestamp is incremented instead.
+func (g *Generator) NewV1() UUID {
+	var u UUID
+	g.opts.stats.lock(&g.mu)
+	ticks := g.greg.next(&g.opts, g.opts.nowNano())
+	putV1(&u, ticks)
+	g.greg.putNode(&u)
+	g.mu.Unlock()
+
+	g.opts.issued(u, V1)
+	return u
+}
+
+// NewV6 returns a new Version 6 (reordered Gregorian time + node) UUID
+// using the package-level default generator. See [Generator.NewV6].
+func NewV6() UUID {
+	u := defaultGen.Load().NewV6()
+	issued(u, V6)
+	return u
+}
+
+// NewV6 returns a new Version 6 UUID: the fields of a V1 UUID with the
+// timestamp stored most significant bits first, so UUIDs sort by creation
+// time. It shares the clock sequence, node ID, and timestamp sequence of
+// [Generator.NewV1], so V1 and V6 UUIDs from one Generator never share a
+// timestamp. Prefer V7 unless V1 compatibility matters; see also [V1ToV6].
+func (g *Generator) NewV6() UUID {
+	var u UUID
+	g.opts.stats.lock(&g.mu)
+	ticks := g.greg.next(&g.opts, g.opts.nowNano())
+	putV6(&u, ticks)
+	g.greg.putNode(&u)
+	g.mu.Unlock()
+
+	g.opts.issued(u, V6)
+	return u
+}
+
+// Domain is the local domain of a DCE Security (Version 2) UUID, which
+// determines how its ID is interpreted.
+type Domain uint8
+
+// DCE Security domains.
+const (
+	DomainPerson Domain = 0 // ID is a POSIX UID
+	DomainGroup  Domain = 1 // ID is a POSIX GID
+	DomainOrg    Domain = 2 // ID is an organization
+)
+
+// String returns the domain name.
+func (d Domain) String() string {
+	switch d {
+	case DomainPerson:
+		return "Person"
+	case DomainGroup:
+		return "Group"
+	case DomainOrg:
+		return "Org"
+	default:
+		return "Domain" + strconv.Itoa(int(d))
+	}
+}
+
+// NewV2 returns a new Version 2 (DCE Security) UUID using the package-level
+// default generator. See [Generator.NewV2].
+func NewV2(domain Domain, id uint32) UUID {
+	u := defaultGen.Load().NewV2(domain, id)
+	issued(u, V2)
+	return u
+}
+
+// NewV2 returns a new Version 2 (DCE Security) UUID, as specified by DCE 1.1
+// Authentication and Security Services: a V1 UUID whose time_low field is
+// replaced by id and whose clock_seq_low field is replaced by domain, for
+// example a POSIX UID:
+//
+//	id := gen.NewV2(uuid.DomainPerson, uint32(os.Getuid()))
+//
+// Because only the upper 28 bits of the timestamp and 6 bits of the clock
+// sequence remain, V2 UUIDs with the same domain, ID, and node created within
+// about 7 minutes of each other may collide. Use them only where DCE
+// conformance is required.
+func (g *Generator) NewV2(domain Domain, id uint32) UUID {
+	var u UUID
+	g.opts.stats.lock(&g.mu)
+	ticks := g.greg.next(&g.opts, g.opts.nowNano())
+	putV1(&u, ticks)
+	g.greg.putNode(&u)
+	g.mu.Unlock()
+
+	binary.BigEndian.PutUint32(u[:4], id)
+	u[6] = (u[6] & 0x0f) | 0x20 // version 2
+	u[9] = byte(domain)
+
+	g.opts.issued(u, V2)
+	return u
+}
+
+// Domain returns the local domain of a V2 (DCE Security) UUID.
+// For other versions, the result is meaningless.
+func (u UUID) Domain() Domain {
+	return Domain(u[9])
+}
+
+// ID returns the local ID, such as a POSIX UID, of a V2 (DCE Security) UUID.
+// For other versions, the result is meaningless.
+func (u UUID) ID() uint32 {
+	return binary.BigEndian.Uint32(u[:4])
+}
+
+// gregorianState is the V1, V2, and V6 state of a Generator.
+// It is guarded by the owner's mutex.
+type gregorianState struct {
+	ready     bool
+	clockSeq  uint16 // 14 bits
+	node      [6]byte
+	lastTicks int64 // timestamp of the last issued UUID
+	lastNano  int64 // last clock reading, for rollback detection
+}
+
+// next returns the timestamp for a UUID generated at the Unix time nano, in
+// 100 ns intervals since the Gregorian epoch, and records it as the last
+// issued timestamp. The clock sequence and node are set up on first use.
+func (st *gregorianState) next(o *options, nano int64) int64 {
+	if !st.ready {
+		var b [8]byte
+		o.readRandom(b[:])
+		st.clockSeq = binary.BigEndian.Uint16(b[:2]) & 0x3fff
+		if o.node != nil {
+			st.node = *o.node
+		} else {
+			copy(st.node[:], b[2:])
+			st.node[0] |= 0x01 // multicast bit marks a random node ID
+		}
+		st.ready = true
+	}
+	if nano < st.lastNano {
+		o.clockRollback(time.Duration(st.lastNano - nano))
+	}
+	st.lastNano = nano
+	ticks := nano/100 + gregorianOffset
+	if ticks <= st.lastTicks {
+		ticks = st.lastTicks + 1
+		o.stats.clamps.Add(1)
+	}
+	st.lastTicks = ticks
+	return ticks
+}
+
+// putNode writes the variant, clock sequence, and node ID into bytes 8–15
+// of u.
+func (st *gregorianState) putNode(u *UUID) {
+	u[8] = 0x80 | byte(st.clockSeq>>8)&0x3f // variant RFC 9562
+	u[9] = byte(st.clockSeq)
+	copy(u[10:], st.node[:])
+}
+
+// putV1 encodes the Gregorian timestamp ticks into bytes 0–7 of u in the
+// V1 field order (time_low, time_mid, time_high) and sets the version bits.
+func putV1(u *UUID, ticks int64) {
+	u[0] = byte(ticks >> 24)
+	u[1] = byte(ticks >> 16)
+	u[2] = byte(ticks >> 8)
+	u[3] = byte(ticks)
+	u[4] = byte(ticks >> 40)
+	u[5] = byte(ticks >> 32)
+	u[6] = 0x10 | byte(ticks>>56)&0x0f // version 1
+	u[7] = byte(ticks >> 48)
+}
+
+// putV6 encodes the Gregorian timestamp ticks into bytes 0–7 of u most
+// significant bits first and sets the version bits.
+func putV6(u *UUID, ticks int64) {
+	u[0] = byte(ticks >> 52)
+	u[1] = byte(ticks >> 44)
+	u[2] = byte(ticks >> 36)
+	u[3] = byte(ticks >> 28)
+	u[4] = byte(ticks >> 20)
+	u[5] = byte(ticks >> 12)
+	u[6] = 0x60 | byte(ticks>>8)&0x0f // version 6
+	u[7] = byte(ticks)
+}
@@ -0,0 +1,105 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestNewV1(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator()
+		a, b := gen.NewV1(), gen.NewV1()
+		for _, u := range []UUID{a, b} {
+			if u.Version() != V1 || u.Variant() != VariantRFC9562 {
+				t.Fatalf("%s: version %v, variant %v", u, u.Version(), u.Variant())
+			}
+			if u[10]&0x01 == 0 {
+				t.Errorf("%s: random node without multicast bit", u)
+			}
+		}
+		// The fake clock did not advance: the timestamp is incremented.
+		at, _ := a.TimeOK()
+		bt, _ := b.TimeOK()
+		if !at.Equal(time.Now()) || bt.Sub(at) != 100*time.Nanosecond {
+			t.Errorf("timestamps = %v, %v, want %v and 100ns later", at, bt, time.Now())
+		}
+		if [8]byte(a[8:]) != [8]byte(b[8:]) {
+			t.Errorf("clock sequence or node changed within a generator: %s, %s", a, b)
+		}
+	})
+}
+
+func TestNewV6(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator()
+		v1, v6 := gen.NewV1(), gen.NewV6()
+		if v6.Version() != V6 || v6.Variant() != VariantRFC9562 {
+			t.Fatalf("%s: version %v, variant %v", v6, v6.Version(), v6.Variant())
+		}
+		// V1 and V6 share the timestamp sequence, clock sequence, and node.
+		t1, _ := v1.TimeOK()
+		t6, _ := v6.TimeOK()
+		if t6.Sub(t1) != 100*time.Nanosecond || [8]byte(v1[8:]) != [8]byte(v6[8:]) {
+			t.Errorf("V1 %s at %v, V6 %s at %v", v1, t1, v6, t6)
+		}
+		time.Sleep(time.Millisecond)
+		if later := NewV6(); Compare(later, v6) <= 0 || later.Version() != V6 {
+			t.Errorf("NewV6() = %s, want a V6 UUID sorting after %s", later, v6)
+		}
+	})
+}
+
+func TestNewV1Node(t *testing.T) {
+	node := [6]byte{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}
+	u := NewGenerator(WithNode(node)).NewV1()
+	if [6]byte(u[10:]) != node {
+		t.Errorf("node = %x, want %x", u[10:], node)
+	}
+	if v1 := NewV1(); v1.Version() != V1 {
+		t.Errorf("NewV1().Version() = %v, want V1", v1.Version())
+	}
+}
+
+func TestGregorianStateClockRollback(t *testing.T) {
+	m := newMetricsRecorder()
+	o := newOptions([]Option{WithMetrics(m)})
+	var st gregorianState
+
+	first := st.next(&o, 10*nanoPerMilli)
+	second := st.next(&o, 7*nanoPerMilli)
+	if second != first+1 {
+		t.Errorf("ticks after rollback = %d, want %d", second, first+1)
+	}
+	if len(m.rollbacks) != 1 || m.rollbacks[0] != 3*time.Millisecond {
+		t.Errorf("rollbacks = %v, want [3ms]", m.rollbacks)
+	}
+}
+
+func TestNewV2(t *testing.T) {
+	node := [6]byte{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}
+	gen := NewGenerator(WithNode(node))
+	v1 := gen.NewV1()
+	u := gen.NewV2(DomainGroup, 1000)
+	if u.Version() != V2 || u.Variant() != VariantRFC9562 {
+		t.Fatalf("%s: version %v, variant %v", u, u.Version(), u.Variant())
+	}
+	if u.Domain() != DomainGroup || u.ID() != 1000 {
+		t.Errorf("Domain(), ID() = %v, %d, want Group, 1000", u.Domain(), u.ID())
+	}
+	if !strings.HasPrefix(u.String(), "000003e8-") || [6]byte(u[10:]) != node || u[8] != v1[8] {
+		t.Errorf("NewV2() = %s, want ID 000003e8, V1 clock sequence and node of %s", u, v1)
+	}
+	if u := NewV2(DomainPerson, 0); u.Version() != V2 || u.Domain() != DomainPerson || u.ID() != 0 {
+		t.Errorf("NewV2(Person, 0) = %s", u)
+	}
+}
+
+func TestDomainString(t *testing.T) {
+	for d, want := range map[Domain]string{DomainPerson: "Person", DomainGroup: "Group", DomainOrg: "Org", 9: "Domain9"} {
+		if got := d.String(); got != want {
+			t.Errorf("Domain(%d).String() = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package uuid
+
+// ToWindowsBytes returns u in the mixed-endian layout of a Win32/COM GUID
+// struct and .NET's Guid.ToByteArray: Data1, Data2, and Data3 (time_low,
+// time_mid, and time_hi_and_version) little-endian, Data4 unchanged.
+// SQL Server stores uniqueidentifier columns in the same layout;
+// [UUID.ToMSSQLBytes] is an alias named for it. Decode with
+// [FromWindowsBytes].
+func (u UUID) ToWindowsBytes() []byte {
+	s := swapMixedEndian(u)
+	return s[:]
+}
+
+// FromWindowsBytes decodes 16 bytes in the mixed-endian GUID layout, as
+// written by [UUID.ToWindowsBytes], a Win32 GUID struct, .NET's
+// Guid.ToByteArray, or a SQL Server uniqueidentifier read through
+// go-mssqldb. It returns a [*LengthError] if b is not 16 bytes long.
+func FromWindowsBytes(b []byte) (UUID, error) {
+	if len(b) != 16 {
+		return Nil, &LengthError{Got: len(b), Want: "16 bytes"}
+	}
+	return swapMixedEndian(UUID(b)), nil
+}
+
+// swapMixedEndian reverses the byte order of the first three fields of u,
+// converting between RFC 9562 and the mixed-endian GUID layout in either
+// direction.
+func swapMixedEndian(u UUID) UUID {
+	u[0], u[1], u[2], u[3] = u[3], u[2], u[1], u[0]
+	u[4], u[5] = u[5], u[4]
+	u[6], u[7] = u[7], u[6]
+	return u
+}
@@ -0,0 +1,25 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestWindowsBytes(t *testing.T) {
+	// .NET: new Guid("6ba7b810-9dad-11d1-80b4-00c04fd430c8").ToByteArray()
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b := u.ToWindowsBytes()
+	if got, want := hex.EncodeToString(b), "10b8a76bad9dd11180b400c04fd430c8"; got != want {
+		t.Errorf("ToWindowsBytes() = %s, want %s", got, want)
+	}
+	back, err := FromWindowsBytes(b)
+	if err != nil || back != u {
+		t.Errorf("FromWindowsBytes() = %s, %v, want %s", back, err, u)
+	}
+
+	_, err = FromWindowsBytes(b[:15])
+	if lerr, ok := errors.AsType[*LengthError](err); !ok || lerr.Got != 15 {
+		t.Errorf("FromWindowsBytes(15 bytes) error = %v, want *LengthError", err)
+	}
+}
@@ -0,0 +1,124 @@
+package uuid
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// DefaultIdempotencyHeader is the header set by [IdempotencyTransport] when
+// its Header field is empty.
+const DefaultIdempotencyHeader = "Idempotency-Key"
+
+// IdempotencyTransport is an [http.RoundTripper] that attaches a UUID to
+// every outgoing request as an idempotency key. Requests that already carry
+// the header keep it, a key set with [WithIdempotencyKey] is used as is, and
+// a redirected request reuses the key of the request that was redirected.
+// Otherwise the key is fresh:
+//
+//	t := &uuid.IdempotencyTransport{New: uuid.NewV7}
+//	client := &http.Client{Transport: t}
+//	resp, err := client.Post(url, "application/json", body)
+//	key, _ := t.Key(resp)
+//
+// To retry after an error, when there is no response to read the key from,
+// choose the key up front with [WithIdempotencyKey].
+type IdempotencyTransport struct {
+	Base   http.RoundTripper // underlying transport; nil means http.DefaultTransport
+	Header string            // header name; empty means DefaultIdempotencyHeader
+	New    func() UUID       // key source; nil means NewV4
+}
+
+// idempotencyKeyCtx is the context key of [WithIdempotencyKey].
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey returns a copy of ctx that makes [IdempotencyTransport]
+// send key with requests made under it, so the caller knows the key before
+// sending and can reuse it for a retry:
+//
+//	key := uuid.NewV7()
+//	req, err := http.NewRequestWithContext(uuid.WithIdempotencyKey(ctx, key), "POST", url, body)
+func WithIdempotencyKey(ctx context.Context, key UUID) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// RoundTrip implements [http.RoundTripper]. It sends a clone of req, as
+// required of RoundTrippers that modify the request.
+func (t *IdempotencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := t.header()
+	if req.Header.Get(header) == "" {
+		key := t.key(req, header)
+		req = req.Clone(req.Context())
+		req.Header.Set(header, key)
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// key returns the idempotency key for req, which carries none: the one from
+// its context, the one sent with the request that redirected to it, or a new
+// one.
+func (t *IdempotencyTransport) key(req *http.Request, header string) string {
+	if key, ok := req.Context().Value(idempotencyKeyCtx{}).(UUID); ok {
+		return key.String()
+	}
+	if prev := req.Response; prev != nil && prev.Request != nil {
+		if key := prev.Request.Header.Get(header); key != "" {
+			return key
+		}
+	}
+	newFn := t.New
+	if newFn == nil {
+		newFn = NewV4
+	}
+	return newFn().String()
+}
+
+// Key returns the idempotency key sent with the request that produced resp.
+// It returns a [*ParseError] if resp is nil or carries no request.
+func (t *IdempotencyTransport) Key(resp *http.Response) (UUID, error) {
+	if resp == nil || resp.Request == nil {
+		return Nil, &ParseError{Msg: "missing response request"}
+	}
+	return ParseLenient(resp.Request.Header.Get(t.header()))
+}
+
+func (t *IdempotencyTransport) header() string {
+	if t.Header == "" {
+		return DefaultIdempotencyHeader
+	}
+	return t.Header
+}
+
+// FromRequestPath parses the path wildcard name of r, as matched by
+// [http.ServeMux], with [Parse]. It returns a [*ParseError] if the value is
+// missing or malformed, so handlers can respond with 400 Bad Request:
+//
+//	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+//	    id, err := uuid.FromRequestPath(r, "id")
+//	    if err != nil {
+//	        http.Error(w, err.Error(), http.StatusBadRequest)
+//	        return
+//	    }
+//	    ...
+//	})
+func FromRequestPath(r *http.Request, name string) (UUID, error) {
+	s := r.PathValue(name)
+	if s == "" {
+		return Nil, &ParseError{Input: s, Msg: "missing path value " + strconv.Quote(name)}
+	}
+	return Parse(s)
+}
+
+// FromRequestQuery is like [FromRequestPath] but reads the first value of
+// the URL query parameter name.
+func FromRequestQuery(r *http.Request, name string) (UUID, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return Nil, &ParseError{Input: s, Msg: "missing query parameter " + strconv.Quote(name)}
+	}
+	return Parse(s)
+}
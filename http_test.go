@@ -0,0 +1,182 @@
+package uuid
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIdempotencyTransport(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(DefaultIdempotencyHeader))
+	}))
+	defer srv.Close()
+
+	tr := &IdempotencyTransport{}
+	client := &http.Client{Transport: tr}
+	for range 2 {
+		resp, err := client.Post(srv.URL, "text/plain", nil)
+		if err != nil {
+			t.Fatalf("Post() error: %v", err)
+		}
+		_ = resp.Body.Close()
+		key, err := tr.Key(resp)
+		if err != nil {
+			t.Fatalf("Key() error: %v", err)
+		}
+		if key.Version() != V4 {
+			t.Errorf("Key().Version() = %v, want V4", key.Version())
+		}
+		if received[len(received)-1] != key.String() {
+			t.Errorf("server saw %q, Key() = %s", received[len(received)-1], key)
+		}
+	}
+	if received[0] == received[1] {
+		t.Errorf("requests shared key %s", received[0])
+	}
+}
+
+func TestIdempotencyTransportOptions(t *testing.T) {
+	var got string
+	base := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Header.Get("X-Request-Key")
+		return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+	})
+	want := MustParse("00000000-0000-7000-8000-000000000001")
+	tr := &IdempotencyTransport{Base: base, Header: "X-Request-Key", New: func() UUID { return want }}
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	if got != want.String() {
+		t.Errorf("header = %q, want %s", got, want)
+	}
+	if req.Header.Get("X-Request-Key") != "" {
+		t.Error("RoundTrip modified the caller's request")
+	}
+	if key, _ := tr.Key(resp); key != want {
+		t.Errorf("Key() = %s, want %s", key, want)
+	}
+
+	// An existing key is preserved for retries.
+	req.Header.Set("X-Request-Key", "retry-key")
+	if _, err := tr.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip() error: %v", err)
+	}
+	if got != "retry-key" {
+		t.Errorf("header = %q, want retry-key", got)
+	}
+}
+
+func TestIdempotencyTransportRedirect(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(DefaultIdempotencyHeader))
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, "/final", http.StatusTemporaryRedirect)
+		}
+	}))
+	defer srv.Close()
+
+	tr := &IdempotencyTransport{}
+	resp, err := (&http.Client{Transport: tr}).Post(srv.URL, "text/plain", nil)
+	if err != nil {
+		t.Fatalf("Post() error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if len(received) != 2 || received[0] != received[1] {
+		t.Errorf("keys across redirect = %q, want one key twice", received)
+	}
+	if key, _ := tr.Key(resp); key.String() != received[0] {
+		t.Errorf("Key() = %s, want %s", key, received[0])
+	}
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	var got string
+	fail := errors.New("connection reset")
+	tr := &IdempotencyTransport{Base: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Header.Get(DefaultIdempotencyHeader)
+		return nil, fail
+	})}
+	key := MustParse("00000000-0000-7000-8000-000000000002")
+	req := httptest.NewRequestWithContext(WithIdempotencyKey(t.Context(), key), http.MethodPost, "http://example.com", nil)
+	for range 2 {
+		if _, err := tr.RoundTrip(req); !errors.Is(err, fail) {
+			t.Fatalf("RoundTrip() error = %v, want %v", err, fail)
+		}
+		if got != key.String() {
+			t.Errorf("header = %q, want %s", got, key)
+		}
+	}
+}
+
+func TestIdempotencyTransportKeyNoRequest(t *testing.T) {
+	tr := &IdempotencyTransport{}
+	for _, resp := range []*http.Response{nil, {}} {
+		_, err := tr.Key(resp)
+		if _, ok := errors.AsType[*ParseError](err); !ok {
+			t.Errorf("Key(%v) error = %v, want *ParseError", resp, err)
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestFromRequestPath(t *testing.T) {
+	const id = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{"valid", "/users/" + id, ""},
+		{"malformed", "/users/bogus", `uuid: parsing "bogus": expected 36-character hyphenated format`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got UUID
+			var err error
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /users/{id}", func(_ http.ResponseWriter, r *http.Request) {
+				got, err = FromRequestPath(r, "id")
+			})
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if tt.wantErr == "" {
+				if err != nil || got.String() != id {
+					t.Errorf("FromRequestPath() = %s, %v", got, err)
+				}
+				return
+			}
+			if _, ok := errors.AsType[*ParseError](err); !ok || err.Error() != tt.wantErr {
+				t.Errorf("FromRequestPath() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := FromRequestPath(httptest.NewRequest(http.MethodGet, "/", nil), "id")
+	if err == nil || err.Error() != `uuid: parsing "": missing path value "id"` {
+		t.Errorf("FromRequestPath(unmatched) error = %v", err)
+	}
+}
+
+func TestFromRequestQuery(t *testing.T) {
+	const id = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	r := httptest.NewRequest(http.MethodGet, "/orders?id="+id+"&bad=xyz", nil)
+	if got, err := FromRequestQuery(r, "id"); err != nil || got.String() != id {
+		t.Errorf("FromRequestQuery(id) = %s, %v", got, err)
+	}
+	if _, err := FromRequestQuery(r, "bad"); err == nil {
+		t.Error("FromRequestQuery(bad) should fail")
+	}
+	_, err := FromRequestQuery(r, "missing")
+	if err == nil || err.Error() != `uuid: parsing "": missing query parameter "missing"` {
+		t.Errorf("FromRequestQuery(missing) error = %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+)
+
+// MarshalJSONTo writes u as a JSON string straight into the encoder's
+// buffer. It implements [encoding/json/v2.MarshalerTo].
+func (u UUID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteValue(u.AppendJSON(enc.AvailableBuffer()))
+}
+
+// UnmarshalJSONFrom parses a JSON string holding the strict 36-character
+// form directly from the decoder's buffer. A JSON null sets u to [Nil].
+// It implements [encoding/json/v2.UnmarshalerFrom].
+func (u *UUID) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	v, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	switch v.Kind() {
+	case 'n':
+		*u = Nil
+		return nil
+	case '"':
+	default:
+		return &ParseError{Input: string(v), Msg: "expected JSON string"}
+	}
+	b := v[1 : len(v)-1]
+	if bytes.IndexByte(b, '\\') >= 0 {
+		// The decoder has already validated the string, so unquoting
+		// cannot fail.
+		b, _ = jsontext.AppendUnquote(nil, v)
+	}
+	return u.UnmarshalText(b)
+}
@@ -0,0 +1,104 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package uuid
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	type row struct {
+		ID  UUID  `json:"id"`
+		Ref *UUID `json:"ref"`
+	}
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	out, err := json.Marshal(row{ID: u, Ref: &u})
+	want := `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","ref":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`
+	if err != nil || string(out) != want {
+		t.Fatalf("json.Marshal() = %s, %v, want %s", out, err, want)
+	}
+	var r row
+	if err := json.Unmarshal(out, &r); err != nil || r.ID != u || r.Ref == nil || *r.Ref != u {
+		t.Errorf("json.Unmarshal() = %+v, %v", r, err)
+	}
+}
+
+func TestJSONv2Unmarshal(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		in   string
+		want UUID
+	}{
+		{`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, u},
+		{`"\u0036ba7b810-9dad-11d1-80b4-00c04fd430c8"`, u},
+		{`null`, Nil},
+	}
+	for _, tt := range tests {
+		got := Max
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil || got != tt.want {
+			t.Errorf("json.Unmarshal(%s) = %s, %v, want %s", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, in := range []string{`42`, `"6ba7b8109dad11d180b400c04fd430c8"`, `"`} {
+		var got UUID
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded, want error", in)
+		}
+	}
+	var got UUID
+	err := json.Unmarshal([]byte(`42`), &got)
+	if _, ok := errors.AsType[*ParseError](err); !ok {
+		t.Errorf("json.Unmarshal(42) error = %v, want *ParseError", err)
+	}
+	if err := got.UnmarshalJSONFrom(jsontext.NewDecoder(strings.NewReader(""))); !errors.Is(err, io.EOF) {
+		t.Errorf("UnmarshalJSONFrom(empty) error = %v, want io.EOF", err)
+	}
+}
+
+// textOnly exercises the TextMarshaler fallback that json/v2 uses for
+// types without MarshalJSONTo and UnmarshalJSONFrom.
+type textOnly [16]byte
+
+func (t textOnly) MarshalText() ([]byte, error) { return UUID(t).MarshalText() }
+
+func (t *textOnly) UnmarshalText(b []byte) error { return (*UUID)(t).UnmarshalText(b) }
+
+func BenchmarkJSONv2Marshal(b *testing.B) {
+	v := []UUID{NewV4(), NewV4(), NewV4(), NewV4()}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = json.Marshal(v)
+	}
+}
+
+func BenchmarkJSONv2MarshalText(b *testing.B) {
+	v := []textOnly{textOnly(NewV4()), textOnly(NewV4()), textOnly(NewV4()), textOnly(NewV4())}
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = json.Marshal(v)
+	}
+}
+
+func BenchmarkJSONv2Unmarshal(b *testing.B) {
+	data, _ := json.Marshal([]UUID{NewV4(), NewV4(), NewV4(), NewV4()})
+	v := make([]UUID, 4)
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = json.Unmarshal(data, &v)
+	}
+}
+
+func BenchmarkJSONv2UnmarshalText(b *testing.B) {
+	data, _ := json.Marshal([]UUID{NewV4(), NewV4(), NewV4(), NewV4()})
+	v := make([]textOnly, 4)
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = json.Unmarshal(data, &v)
+	}
+}
@@ -0,0 +1,67 @@
+package uuid
+
+import "database/sql/driver"
+
+// LenientUUID is a UUID whose UnmarshalText and UnmarshalJSON accept every
+// form [ParseLenient] does (URN, braced, compact), while encoding exactly
+// like [UUID]. Use it in public request types that must
+// tolerate clients sending any common form, without relaxing [UUID] itself:
+//
+//	type Request struct {
+//	    ID uuid.LenientUUID `json:"id"`
+//	}
+type LenientUUID UUID
+
+// UUID returns l as a plain [UUID].
+func (l LenientUUID) UUID() UUID {
+	return UUID(l)
+}
+
+// String returns the standard 36-character hyphenated representation.
+func (l LenientUUID) String() string {
+	return UUID(l).String()
+}
+
+// MarshalText returns the 36-character hyphenated representation.
+// It implements [encoding.TextMarshaler].
+func (l LenientUUID) MarshalText() ([]byte, error) {
+	return UUID(l).MarshalText()
+}
+
+// UnmarshalText parses a UUID from text with [ParseLenient].
+// It implements [encoding.TextUnmarshaler].
+func (l *LenientUUID) UnmarshalText(data []byte) error {
+	u, err := ParseLenient(string(data))
+	if err != nil {
+		return err
+	}
+	*l = LenientUUID(u)
+	return nil
+}
+
+// UnmarshalJSON parses a JSON string in any form [ParseLenient] accepts.
+// A JSON null resets l to [Nil] rather than leaving it unchanged.
+// It implements [encoding/json.Unmarshaler].
+func (l *LenientUUID) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonText(data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		*l = LenientUUID(Nil)
+		return nil
+	}
+	return l.UnmarshalText(s)
+}
+
+// Scan implements [database/sql.Scanner]. Like [UUID.Scan], it accepts
+// every form [ParseLenient] does.
+func (l *LenientUUID) Scan(src any) error {
+	return (*UUID)(l).Scan(src)
+}
+
+// Value implements [database/sql/driver.Valuer].
+// It returns the UUID as a 36-character string.
+func (l LenientUUID) Value() (driver.Value, error) {
+	return UUID(l).Value()
+}
@@ -0,0 +1,57 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLenientUUIDJSON(t *testing.T) {
+	type doc struct {
+		ID LenientUUID `json:"id"`
+	}
+	want := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	for _, in := range []string{
+		want,
+		"urn:uuid:" + want,
+		"{" + want + "}",
+		"6BA7B8109DAD11D180B400C04FD430C8",
+	} {
+		var d doc
+		if err := json.Unmarshal([]byte(`{"id":"`+in+`"}`), &d); err != nil {
+			t.Fatalf("json.Unmarshal(%q) error: %v", in, err)
+		}
+		if d.ID.String() != want || d.ID.UUID() != MustParse(want) {
+			t.Errorf("json.Unmarshal(%q) = %s, want %s", in, d.ID, want)
+		}
+		b, err := json.Marshal(d)
+		if err != nil || string(b) != `{"id":"`+want+`"}` {
+			t.Errorf("json.Marshal() = %s, %v", b, err)
+		}
+	}
+
+	var d doc
+	err := json.Unmarshal([]byte(`{"id":"bogus"}`), &d)
+	if _, ok := errors.AsType[*ParseError](err); !ok {
+		t.Errorf("json.Unmarshal(bogus) error = %v, want *ParseError", err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":42}`), &d); err == nil {
+		t.Error("json.Unmarshal(42) succeeded, want error")
+	}
+
+	d.ID = LenientUUID(MustParse(want))
+	if err := json.Unmarshal([]byte(`{"id":null}`), &d); err != nil || d.ID.UUID() != Nil {
+		t.Errorf("json.Unmarshal(null) = %s, %v, want Nil", d.ID, err)
+	}
+}
+
+func TestLenientUUIDScanValue(t *testing.T) {
+	var l LenientUUID
+	if err := l.Scan("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	v, err := l.Value()
+	if err != nil || v != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+}
@@ -0,0 +1,79 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+)
+
+// V1ToV6 converts a V1 UUID into the V6 UUID with the same timestamp, clock
+// sequence, and node. V6 stores the timestamp most significant bits first,
+// so converted keys sort by creation time (to 100 ns) and the conversion
+// preserves the order of any two V1 UUIDs with distinct timestamps. It
+// returns an error if u is not a V1 UUID; [V6ToV1] reverses it.
+func V1ToV6(u UUID) (UUID, error) {
+	if u.Version() != V1 {
+		return Nil, fmt.Errorf("uuid: %s is %v, not V1", u, u.Version())
+	}
+	putV6(&u, gregorianTicks(u))
+	return u, nil
+}
+
+// V6ToV1 converts a V6 UUID back into the V1 UUID with the same timestamp,
+// clock sequence, and node. It returns an error if u is not a V6 UUID.
+func V6ToV1(u UUID) (UUID, error) {
+	if u.Version() != V6 {
+		return Nil, fmt.Errorf("uuid: %s is %v, not V6", u, u.Version())
+	}
+	putV1(&u, gregorianTicks(u))
+	return u, nil
+}
+
+// V1ToV7 converts a V1 UUID into a V7 UUID with the same creation time:
+// unix_ts_ms holds the millisecond and rand_a the sub-millisecond fraction
+// (RFC 9562 Method 3), so converted keys sort by creation time to about
+// 244 ns. rand_b is random, discarding the clock sequence and MAC address.
+// It returns an error if u is not a V1 UUID or predates the Unix epoch.
+// Use [V1ToV7Keyed] for a repeatable conversion.
+func V1ToV7(u UUID) (UUID, error) {
+	v, err := v1ToV7(u)
+	if err != nil {
+		return Nil, err
+	}
+	_, _ = randRead(v[8:])
+	v[8] = (v[8] & 0x3f) | 0x80 // variant RFC 9562
+	return v, nil
+}
+
+// V1ToV7Keyed is like [V1ToV7] but derives rand_b from HMAC-SHA-256 of u
+// under key, so re-running a migration maps every V1 UUID to the same V7
+// UUID and distinct V1 UUIDs remain distinct (with 62-bit collision
+// resistance within a timestamp). Keep key secret to avoid revealing
+// whether two V7 UUIDs share a V1 origin.
+func V1ToV7Keyed(u UUID, key []byte) (UUID, error) {
+	v, err := v1ToV7(u)
+	if err != nil {
+		return Nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(u[:])
+	copy(v[8:], mac.Sum(nil))
+	v[8] = (v[8] & 0x3f) | 0x80 // variant RFC 9562
+	return v, nil
+}
+
+// v1ToV7 returns the V7 timestamp and rand_a for the V1 UUID u, with
+// rand_b left zero.
+func v1ToV7(u UUID) (UUID, error) {
+	if u.Version() != V1 {
+		return Nil, fmt.Errorf("uuid: %s is %v, not V1", u, u.Version())
+	}
+	ticks := gregorianTicks(u) - gregorianOffset
+	if ticks < 0 {
+		return Nil, fmt.Errorf("uuid: %s predates the Unix epoch", u)
+	}
+	var v UUID
+	ms, frac := ticks/10_000, ticks%10_000*4096/10_000
+	putV7(&v, ms<<12|frac)
+	return v, nil
+}
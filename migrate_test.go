@@ -0,0 +1,109 @@
+package uuid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 9562 Appendix A.1, A.5, and A.6: the same instant as V1, V6, and V7.
+var (
+	rfcV1   = MustParse("c232ab00-9414-11ec-b3c8-9f6bccd3b5bb")
+	rfcV6   = MustParse("1ec9414c-232a-6b00-b3c8-9f6bccd3b5bb")
+	rfcTime = time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+)
+
+func TestV1ToV6(t *testing.T) {
+	v6, err := V1ToV6(rfcV1)
+	if err != nil || v6 != rfcV6 {
+		t.Fatalf("V1ToV6() = %s, %v, want %s", v6, err, rfcV6)
+	}
+	v1, err := V6ToV1(v6)
+	if err != nil || v1 != rfcV1 {
+		t.Errorf("V6ToV1() = %s, %v, want %s", v1, err, rfcV1)
+	}
+	for _, u := range []UUID{rfcV1, rfcV6} {
+		if got, ok := u.TimeOK(); !ok || !got.Equal(rfcTime) {
+			t.Errorf("%v TimeOK() = %v, %v, want %v", u.Version(), got, ok, rfcTime)
+		}
+		if got, ok := u.TimePrecise(); !ok || !got.Equal(rfcTime) {
+			t.Errorf("%v TimePrecise() = %v, %v, want %v", u.Version(), got, ok, rfcTime)
+		}
+	}
+
+	if _, err := V1ToV6(rfcV6); err == nil {
+		t.Error("V1ToV6(V6) succeeded")
+	}
+	if _, err := V6ToV1(rfcV1); err == nil {
+		t.Error("V6ToV1(V1) succeeded")
+	}
+}
+
+func TestV1ToV6PreservesOrder(t *testing.T) {
+	// V1 UUIDs 100 ns, 1 s, and millennia apart, whose V1 byte order differs.
+	v1s := []UUID{
+		MustParse("c232ab00-9414-11ec-b3c8-9f6bccd3b5bb"),
+		MustParse("c232ab01-9414-11ec-b3c8-000000000000"),
+		MustParse("c2cb4180-9414-11ec-b3c8-9f6bccd3b5bb"),
+		MustParse("00000000-0000-1f00-8000-000000000000"),
+	}
+	var v6s, v7s []UUID
+	for _, u := range v1s {
+		v6, _ := V1ToV6(u)
+		v7, err := V1ToV7Keyed(u, []byte("key"))
+		if err != nil {
+			t.Fatalf("V1ToV7Keyed(%s) error: %v", u, err)
+		}
+		v6s = append(v6s, v6)
+		v7s = append(v7s, v7)
+	}
+	if !slices.IsSortedFunc(v6s, Compare) {
+		t.Errorf("V6 UUIDs not in creation order: %v", v6s)
+	}
+	// The first two are 100 ns apart and share a Method 3 fraction.
+	if !slices.IsSortedFunc(v7s[1:], Compare) || Compare(v7s[0], v7s[2]) >= 0 {
+		t.Errorf("V7 UUIDs not in creation order: %v", v7s)
+	}
+}
+
+func TestV1ToV7(t *testing.T) {
+	v7, err := V1ToV7(rfcV1)
+	if err != nil {
+		t.Fatalf("V1ToV7() error: %v", err)
+	}
+	if !strings.HasPrefix(v7.String(), "017f22e2-79b0-7000-") || v7.Variant() != VariantRFC9562 {
+		t.Errorf("V1ToV7() = %s, want 017f22e2-79b0-7000-... with RFC 9562 variant", v7)
+	}
+	if again, _ := V1ToV7(rfcV1); again == v7 {
+		t.Error("V1ToV7() is deterministic, want random rand_b")
+	}
+
+	k1, _ := V1ToV7Keyed(rfcV1, []byte("k1"))
+	k1Again, _ := V1ToV7Keyed(rfcV1, []byte("k1"))
+	k2, _ := V1ToV7Keyed(rfcV1, []byte("k2"))
+	if k1 != k1Again || k1 == k2 || k1.Time() != v7.Time() || k1.Variant() != VariantRFC9562 {
+		t.Errorf("V1ToV7Keyed() = %s, %s, %s; want repeatable per key", k1, k1Again, k2)
+	}
+
+	// 1.5 ms after the Unix epoch: rand_a holds the half millisecond.
+	u := MustParse("13814000-1dd2-11b2-8000-000000000000")
+	u[2], u[3] = 0x7a, 0x98 // +15000 ticks
+	v7, err = V1ToV7Keyed(u, nil)
+	if err != nil || v7.TimestampBits() != 1 || v7.RandA() != 2048 {
+		t.Errorf("V1ToV7Keyed() = %s, %v, want timestamp 1 ms, rand_a 2048", v7, err)
+	}
+}
+
+func TestV1ToV7Errors(t *testing.T) {
+	if _, err := V1ToV7(rfcV6); err == nil || err.Error() != "uuid: "+rfcV6.String()+" is V6, not V1" {
+		t.Errorf("V1ToV7(V6) error = %v", err)
+	}
+	old := MustParse("00000000-0000-1000-8000-000000000000")
+	if _, err := V1ToV7Keyed(old, nil); err == nil || !strings.Contains(err.Error(), "predates the Unix epoch") {
+		t.Errorf("V1ToV7Keyed(1582) error = %v", err)
+	}
+	if _, err := V1ToV7(old); err == nil {
+		t.Error("V1ToV7(1582) succeeded")
+	}
+}
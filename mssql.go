@@ -0,0 +1,80 @@
+package uuid
+
+import "database/sql/driver"
+
+// ToMSSQLBytes returns the 16 bytes SQL Server stores for u in a
+// uniqueidentifier column. SQL Server uses the Windows GUID layout, so this
+// is [UUID.ToWindowsBytes] under a name that matches the database. Decode
+// with [FromMSSQLBytes].
+func (u UUID) ToMSSQLBytes() []byte {
+	return u.ToWindowsBytes()
+}
+
+// FromMSSQLBytes decodes 16 bytes in SQL Server's uniqueidentifier layout,
+// as returned by go-mssqldb or [UUID.ToMSSQLBytes]. It is
+// [FromWindowsBytes] and returns a [*LengthError] if b is not 16 bytes long.
+func FromMSSQLBytes(b []byte) (UUID, error) {
+	return FromWindowsBytes(b)
+}
+
+// MSSQLUUID is a UUID for SQL Server uniqueidentifier columns. go-mssqldb
+// exchanges those as 16 mixed-endian bytes, which [UUID.Scan] would read
+// with the first three fields reversed. MSSQLUUID's Scan and Value do the
+// byte swapping; it encodes as text like [UUID] everywhere else:
+//
+//	var id uuid.MSSQLUUID
+//	err := db.QueryRow("SELECT id FROM users WHERE email = @p1", email).Scan(&id)
+type MSSQLUUID UUID
+
+// UUID returns m as a plain [UUID].
+func (m MSSQLUUID) UUID() UUID {
+	return UUID(m)
+}
+
+// String returns the standard 36-character hyphenated representation.
+func (m MSSQLUUID) String() string {
+	return UUID(m).String()
+}
+
+// MarshalText returns the 36-character hyphenated representation.
+// It implements [encoding.TextMarshaler].
+func (m MSSQLUUID) MarshalText() ([]byte, error) {
+	return UUID(m).MarshalText()
+}
+
+// UnmarshalText parses a UUID from text (strict 36-char format).
+// It implements [encoding.TextUnmarshaler].
+func (m *MSSQLUUID) UnmarshalText(data []byte) error {
+	return (*UUID)(m).UnmarshalText(data)
+}
+
+// UnmarshalJSON parses a JSON string in the canonical text form, not the
+// byte-swapped SQL Server layout, and decodes null to [Nil].
+// It implements [encoding/json.Unmarshaler].
+func (m *MSSQLUUID) UnmarshalJSON(data []byte) error {
+	s, null, err := jsonText(data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		*m = MSSQLUUID(Nil)
+		return nil
+	}
+	return m.UnmarshalText(s)
+}
+
+// Scan implements [database/sql.Scanner]. 16 raw bytes are decoded with
+// [FromMSSQLBytes]; text forms are parsed like [UUID.Scan].
+func (m *MSSQLUUID) Scan(src any) error {
+	if b, ok := src.([]byte); ok && len(b) == 16 {
+		*m = MSSQLUUID(swapMixedEndian(UUID(b)))
+		return nil
+	}
+	return (*UUID)(m).Scan(src)
+}
+
+// Value implements [database/sql/driver.Valuer].
+// It returns the 16 bytes in SQL Server's mixed-endian layout.
+func (m MSSQLUUID) Value() (driver.Value, error) {
+	return UUID(m).ToMSSQLBytes(), nil
+}
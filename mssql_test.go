@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMSSQLBytes(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b := u.ToMSSQLBytes()
+	if !bytes.Equal(b, u.ToWindowsBytes()) {
+		t.Errorf("ToMSSQLBytes() = %x, want ToWindowsBytes() %x", b, u.ToWindowsBytes())
+	}
+	back, err := FromMSSQLBytes(b)
+	if err != nil || back != u {
+		t.Errorf("FromMSSQLBytes() = %s, %v, want %s", back, err, u)
+	}
+
+	_, err = FromMSSQLBytes(b[:15])
+	if lerr, ok := errors.AsType[*LengthError](err); !ok || lerr.Got != 15 {
+		t.Errorf("FromMSSQLBytes(15 bytes) error = %v, want *LengthError", err)
+	}
+}
+
+func TestMSSQLUUIDScanValue(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	m := MSSQLUUID(u)
+	if m.UUID() != u || m.String() != u.String() {
+		t.Errorf("MSSQLUUID = %s, want %s", m, u)
+	}
+
+	v, err := m.Value()
+	if raw, ok := v.([]byte); err != nil || !ok || !bytes.Equal(raw, u.ToMSSQLBytes()) {
+		t.Errorf("Value() = %#v, %v, want mixed-endian bytes", v, err)
+	}
+	for _, src := range []any{u.ToMSSQLBytes(), u.String(), []byte(u.String())} {
+		var got MSSQLUUID
+		if err := got.Scan(src); err != nil || got.UUID() != u {
+			t.Errorf("Scan(%v) = %s, %v, want %s", src, got, err, u)
+		}
+	}
+	var got MSSQLUUID
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want error")
+	}
+
+	text, err := m.MarshalText()
+	if err != nil || string(text) != u.String() {
+		t.Errorf("MarshalText() = %s, %v", text, err)
+	}
+	if err := got.UnmarshalText(text); err != nil || got != m {
+		t.Errorf("UnmarshalText() = %s, %v, want %s", got, err, m)
+	}
+}
+
+func TestMSSQLUUIDJSON(t *testing.T) {
+	type row struct {
+		ID MSSQLUUID `json:"id"`
+	}
+	in := `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`
+	var r row
+	if err := json.Unmarshal([]byte(in), &r); err != nil || r.ID.String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("json.Unmarshal() = %s, %v", r.ID, err)
+	}
+	out, err := json.Marshal(r)
+	if err != nil || string(out) != in {
+		t.Errorf("json.Marshal() = %s, %v, want %s", out, err, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":null}`), &r); err != nil || r.ID.UUID() != Nil {
+		t.Errorf("json.Unmarshal(null) = %s, %v, want Nil", r.ID, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":42}`), &r); err == nil {
+		t.Error("json.Unmarshal(42) succeeded, want error")
+	}
+}
@@ -0,0 +1,37 @@
+package uuid
+
+// ToMySQLOrdered returns the 16 bytes MySQL's UUID_TO_BIN(u, 1) stores for
+// u: time_high and time_mid moved in front of time_low, so V1 UUIDs sort by
+// time in BINARY(16) columns. Decode with [FromMySQLOrdered], which matches
+// BIN_TO_UUID(b, 1).
+func (u UUID) ToMySQLOrdered() []byte {
+	s := swapTimeFields(u)
+	return s[:]
+}
+
+// FromMySQLOrdered decodes 16 bytes written by MySQL's UUID_TO_BIN(u, 1)
+// or [UUID.ToMySQLOrdered]. It returns a [*LengthError] if b is not 16
+// bytes long.
+func FromMySQLOrdered(b []byte) (UUID, error) {
+	if len(b) != 16 {
+		return Nil, &LengthError{Got: len(b), Want: "16 bytes"}
+	}
+	var u UUID
+	copy(u[0:4], b[4:8])
+	copy(u[4:6], b[2:4])
+	copy(u[6:8], b[0:2])
+	copy(u[8:], b[8:])
+	return u, nil
+}
+
+// swapTimeFields moves time_high (bytes 6–7) and time_mid (bytes 4–5) of u
+// in front of time_low (bytes 0–3), the layout of MySQL's
+// UUID_TO_BIN(u, 1), which makes V1 UUIDs sort by time.
+func swapTimeFields(u UUID) UUID {
+	var s UUID
+	copy(s[0:2], u[6:8])
+	copy(s[2:4], u[4:6])
+	copy(s[4:8], u[0:4])
+	copy(s[8:], u[8:])
+	return s
+}
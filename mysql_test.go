@@ -0,0 +1,25 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestMySQLOrdered(t *testing.T) {
+	// Example from the MySQL reference manual for UUID_TO_BIN.
+	u := MustParse("6ccd780c-baba-1026-9564-5b8c656024db")
+	b := u.ToMySQLOrdered()
+	if got, want := hex.EncodeToString(b), "1026baba6ccd780c95645b8c656024db"; got != want {
+		t.Errorf("ToMySQLOrdered() = %s, want %s", got, want)
+	}
+	back, err := FromMySQLOrdered(b)
+	if err != nil || back != u {
+		t.Errorf("FromMySQLOrdered() = %s, %v, want %s", back, err, u)
+	}
+
+	_, err = FromMySQLOrdered(b[:15])
+	if lerr, ok := errors.AsType[*LengthError](err); !ok || lerr.Got != 15 {
+		t.Errorf("FromMySQLOrdered(15 bytes) error = %v, want *LengthError", err)
+	}
+}
@@ -0,0 +1,101 @@
+package uuid
+
+import "encoding/base32"
+
+// ncnameBase32 is the RFC 4648 base32 alphabet, written in lower case by
+// [UUID.EncodeNCName32].
+var ncnameBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EncodeNCName returns u in the 22-character base64 form of
+// draft-taylor-uuid-ncname, such as EypfiY17tydeqrQxOS55VK: a bookend
+// letter A–P for the version, 20 URL-safe base64 characters for the 120
+// bits that remain without the version and variant nibbles, and a bookend
+// for the variant nibble. It always starts with a letter, so it is a valid
+// XML NCName, HTML id, and identifier in most programming languages.
+// Decode with [ParseNCName].
+func (u UUID) EncodeNCName() string {
+	c := ncnameContent(u)
+	var buf [22]byte
+	buf[0] = 'A' + u[6]>>4
+	base64URL.Encode(buf[1:21], c[:])
+	buf[21] = 'A' + u[8]>>4
+	return string(buf[:])
+}
+
+// EncodeNCName32 returns u in the 26-character, case-insensitive base32
+// form of draft-taylor-uuid-ncname, in lower case, such as
+// ezkl6ey265xe5pkvnbrhexhsvk. Decode with [ParseNCName].
+func (u UUID) EncodeNCName32() string {
+	c := ncnameContent(u)
+	var buf [26]byte
+	buf[0] = 'a' + u[6]>>4
+	ncnameBase32.Encode(buf[1:25], c[:])
+	for i := 1; i < 25; i++ {
+		if buf[i] >= 'A' {
+			buf[i] += 'a' - 'A'
+		}
+	}
+	buf[25] = 'a' + u[8]>>4
+	return string(buf[:])
+}
+
+// ParseNCName parses either NCName form, telling them apart by length: 22
+// characters for [UUID.EncodeNCName] and 26 for [UUID.EncodeNCName32],
+// which is read case-insensitively.
+func ParseNCName(s string) (UUID, error) {
+	var c [15]byte
+	switch len(s) {
+	case 22:
+		if _, err := base64URL.Decode(c[:], []byte(s[1:21])); err != nil {
+			return Nil, &ParseError{Input: s, Msg: "invalid base64 NCName"}
+		}
+	case 26:
+		var up [24]byte
+		for i := range up {
+			b := s[1+i]
+			if 'a' <= b && b <= 'z' {
+				b -= 'a' - 'A'
+			}
+			up[i] = b
+		}
+		if _, err := ncnameBase32.Decode(c[:], up[:]); err != nil {
+			return Nil, &ParseError{Input: s, Msg: "invalid base32 NCName"}
+		}
+	default:
+		return Nil, &ParseError{Input: s, Msg: "expected 22- or 26-character NCName"}
+	}
+	version, ok1 := ncnameBookend(s[0])
+	variant, ok2 := ncnameBookend(s[len(s)-1])
+	if !ok1 || !ok2 {
+		return Nil, &ParseError{Input: s, Msg: "expected bookend letters A-P"}
+	}
+	var u UUID
+	copy(u[:6], c[:6])
+	u[6] = version<<4 | c[6]>>4
+	u[7] = c[6]<<4 | c[7]>>4
+	u[8] = variant<<4 | c[7]&0x0f
+	copy(u[9:], c[8:])
+	return u, nil
+}
+
+// ncnameContent returns the 120 bits of u without the version nibble
+// (bits 48–51) and the variant nibble (bits 64–67).
+func ncnameContent(u UUID) [15]byte {
+	var c [15]byte
+	copy(c[:6], u[:6])
+	c[6] = u[6]<<4 | u[7]>>4
+	c[7] = u[7]<<4 | u[8]&0x0f
+	copy(c[8:], u[9:])
+	return c
+}
+
+// ncnameBookend decodes a bookend letter, A–P in either case, to a nibble.
+func ncnameBookend(b byte) (byte, bool) {
+	switch {
+	case 'A' <= b && b <= 'P':
+		return b - 'A', true
+	case 'a' <= b && b <= 'p':
+		return b - 'a', true
+	}
+	return 0, false
+}
@@ -0,0 +1,49 @@
+package uuid
+
+import "testing"
+
+func TestNCName(t *testing.T) {
+	tests := []struct {
+		uuid   UUID
+		want64 string
+		want32 string
+	}{
+		{Nil, "AAAAAAAAAAAAAAAAAAAAAA", "aaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		{Max, "P____________________P", "p777777777777777777777777p"},
+		{MustParse("ca97e263-5eed-4c9d-a7aa-ad0c4e4b9e55"), "EypfiY17tydeqrQxOS55VK", "ezkl6ey265xe5pkvnbrhexhsvk"},
+		{MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "Ba6e4EJ2tHRC0AMBP1DDII", "bnot3qee5vuorbnaaybh5imgii"},
+	}
+	for _, tt := range tests {
+		if got := tt.uuid.EncodeNCName(); got != tt.want64 {
+			t.Errorf("%s.EncodeNCName() = %q, want %q", tt.uuid, got, tt.want64)
+		}
+		if got := tt.uuid.EncodeNCName32(); got != tt.want32 {
+			t.Errorf("%s.EncodeNCName32() = %q, want %q", tt.uuid, got, tt.want32)
+		}
+		for _, s := range []string{tt.want64, tt.want32} {
+			if got, err := ParseNCName(s); err != nil || got != tt.uuid {
+				t.Errorf("ParseNCName(%q) = %s, %v, want %s", s, got, err, tt.uuid)
+			}
+		}
+	}
+
+	want := MustParse("ca97e263-5eed-4c9d-a7aa-ad0c4e4b9e55")
+	if got, err := ParseNCName("EZKL6EY265XE5PKVNBRHEXHSVK"); err != nil || got != want {
+		t.Errorf("ParseNCName(upper-case base32) = %s, %v, want %s", got, err, want)
+	}
+}
+
+func TestParseNCNameErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"EypfiY17tydeqrQxOS55V",      // 21 characters
+		"EypfiY17tydeqrQxOS55VZ",     // variant bookend out of range
+		"QypfiY17tydeqrQxOS55VK",     // version bookend out of range
+		"Eypfi+17tydeqrQxOS55VK",     // not URL-safe base64
+		"ezkl6ey265xe5pkvnbrhexhs1k", // 1 is not in the base32 alphabet
+	} {
+		if _, err := ParseNCName(s); err == nil {
+			t.Errorf("ParseNCName(%q) should fail", s)
+		}
+	}
+}
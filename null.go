@@ -0,0 +1,31 @@
+package uuid
+
+import "database/sql"
+
+// The package represents a nullable UUID as *UUID, which database/sql scans
+// and binds as SQL NULL when nil. [database/sql.Null] of UUID works as well:
+// its Scan and Value delegate to [UUID.Scan] and [UUID.Value]. These helpers
+// convert between the two forms.
+
+// NullFrom returns a valid [sql.Null] holding u.
+func NullFrom(u UUID) sql.Null[UUID] {
+	return sql.Null[UUID]{V: u, Valid: true}
+}
+
+// NullFromPtr returns a [sql.Null] holding *p, which is valid if p is not
+// nil.
+func NullFromPtr(p *UUID) sql.Null[UUID] {
+	if p == nil {
+		return sql.Null[UUID]{}
+	}
+	return NullFrom(*p)
+}
+
+// FromNull returns a pointer to the UUID held by n, or nil if n is not
+// valid.
+func FromNull(n sql.Null[UUID]) *UUID {
+	if !n.Valid {
+		return nil
+	}
+	return &n.V
+}
@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestNullHelpers(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	if n := NullFrom(u); !n.Valid || n.V != u {
+		t.Errorf("NullFrom() = %+v", n)
+	}
+	if n := NullFromPtr(nil); n.Valid {
+		t.Errorf("NullFromPtr(nil) = %+v, want invalid", n)
+	}
+	if p := FromNull(NullFromPtr(&u)); p == nil || *p != u {
+		t.Errorf("FromNull(NullFromPtr(&u)) = %v, want %s", p, u)
+	}
+	if p := FromNull(sql.Null[UUID]{}); p != nil {
+		t.Errorf("FromNull(invalid) = %v, want nil", p)
+	}
+}
+
+func TestSQLNullScanValue(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		src  any
+		want sql.Null[UUID]
+	}{
+		{nil, sql.Null[UUID]{}},
+		{u.String(), NullFrom(u)},
+		{[]byte(u.String()), NullFrom(u)},
+		{u[:], NullFrom(u)},
+	}
+	for _, tt := range tests {
+		var n sql.Null[UUID]
+		if err := n.Scan(tt.src); err != nil || n != tt.want {
+			t.Errorf("Scan(%v) = %+v, %v, want %+v", tt.src, n, err, tt.want)
+		}
+	}
+	var n sql.Null[UUID]
+	if err := n.Scan(42); err == nil {
+		t.Error("Scan(42) succeeded, want error")
+	}
+
+	if v, err := NullFrom(u).Value(); err != nil || v != u.String() {
+		t.Errorf("Value() = %v, %v, want %q", v, err, u.String())
+	}
+	if v, err := (sql.Null[UUID]{}).Value(); err != nil || v != nil {
+		t.Errorf("invalid Value() = %v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,30 @@
+package uuid
+
+// Time-bucket layouts for [ObjectKey], in [time.Time.Format] syntax.
+const (
+	BucketMonth = "2006/01"
+	BucketDay   = "2006/01/02"
+	BucketHour  = "2006/01/02/15"
+)
+
+// ObjectKey composes a storage object key from a V7 UUID: prefix, the UUID's
+// embedded timestamp formatted in UTC with layout, and the UUID itself,
+// joined by slashes. An empty prefix or layout is omitted.
+//
+//	uuid.ObjectKey("uploads", uuid.BucketDay, id) // "uploads/2024/05/17/018f8a3e-..."
+//
+// Keys sort by time within each bucket and are unique as long as the UUIDs
+// are. For non-V7 UUIDs the time bucket is meaningless.
+func ObjectKey(prefix, layout string, u UUID) string {
+	b := make([]byte, 0, len(prefix)+len(layout)+2+36)
+	if prefix != "" {
+		b = append(b, prefix...)
+		b = append(b, '/')
+	}
+	if layout != "" {
+		b = u.Time().UTC().AppendFormat(b, layout)
+		b = append(b, '/')
+	}
+	b, _ = u.AppendText(b)
+	return string(b)
+}
@@ -0,0 +1,24 @@
+package uuid
+
+import "testing"
+
+func TestObjectKey(t *testing.T) {
+	// 2024-05-17T13:25:37.595Z
+	id := MustParse("018f86ba-c2bb-7000-8000-000000000001")
+	tests := []struct {
+		prefix, layout string
+		want           string
+	}{
+		{"uploads", BucketDay, "uploads/2024/05/17/018f86ba-c2bb-7000-8000-000000000001"},
+		{"uploads", BucketHour, "uploads/2024/05/17/13/018f86ba-c2bb-7000-8000-000000000001"},
+		{"a/b", BucketMonth, "a/b/2024/05/018f86ba-c2bb-7000-8000-000000000001"},
+		{"", BucketDay, "2024/05/17/018f86ba-c2bb-7000-8000-000000000001"},
+		{"uploads", "", "uploads/018f86ba-c2bb-7000-8000-000000000001"},
+		{"", "", "018f86ba-c2bb-7000-8000-000000000001"},
+	}
+	for _, tt := range tests {
+		if got := ObjectKey(tt.prefix, tt.layout, id); got != tt.want {
+			t.Errorf("ObjectKey(%q, %q) = %q, want %q", tt.prefix, tt.layout, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Option configures a [Generator] or [Pool].
+type Option func(*options)
+
+// options holds the optional configuration shared by Generator and Pool.
+type options struct {
+	entropyHook  EntropyHook
+	generateHook GenerateHook
+	metrics      MetricsHook
+	precision    int64 // V7 timestamp interval in ms; 0 = RFC 9562 Method 3
+	descending   bool  // invert V7 timestamp bits so newer UUIDs sort first
+	guard        *dupGuard
+	node         *[6]byte // V1/V2/V6 node ID; nil = random
+	clock        func() time.Time
+	rand         io.Reader // entropy source; nil = crypto/rand
+	method       V7Method
+	extBits      uint8 // V7 counter bits beyond rand_a, in rand_b
+	rollback     RollbackPolicy
+	stats        *counters
+}
+
+func newOptions(opts []Option) options {
+	o := options{stats: new(counters)}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// EntropyHook is called after every read from the entropy source with the
+// number of bytes read, the error (if any), and how long the read took.
+// It runs synchronously on the generating goroutine and must not call back
+// into the Generator or Pool that invoked it.
+type EntropyHook func(n int, err error, latency time.Duration)
+
+// WithEntropyHook installs a hook that observes every entropy read, so
+// deployments can alert on slow or failing randomness.
+func WithEntropyHook(h EntropyHook) Option {
+	return func(o *options) {
+		o.entropyHook = h
+	}
+}
+
+// GenerateHook is called with every UUID minted by a [Generator] or [Pool]
+// and its version, for auditing or rate accounting. It runs synchronously on
+// the generating goroutine after the generator's lock is released.
+type GenerateHook func(u UUID, v Version)
+
+// WithGenerateHook installs a hook that observes every minted UUID.
+// Batch calls invoke it once per UUID.
+func WithGenerateHook(h GenerateHook) Option {
+	return func(o *options) {
+		o.generateHook = h
+	}
+}
+
+// packageHook observes UUIDs minted by the package-level functions.
+var packageHook atomic.Pointer[GenerateHook]
+
+// SetGenerateHook installs h to observe every UUID minted by the
+// package-level [NewV4], [NewV4FromReader], [NewV4Batch],
+// [NewV4BatchContext], [FillV4], [V4Seq], [NewV7], [NewV7FromReader],
+// [NewV1], [NewV2], and [NewV6] functions. Passing nil removes the hook.
+// Generators and pools are configured separately with [WithGenerateHook].
+func SetGenerateHook(h GenerateHook) {
+	if h == nil {
+		packageHook.Store(nil)
+		return
+	}
+	packageHook.Store(&h)
+}
+
+// issued reports a UUID minted by a package-level function.
+func issued(u UUID, v Version) {
+	if h := packageHook.Load(); h != nil {
+		(*h)(u, v)
+	}
+}
+
+// issued reports a single minted UUID to the metrics and generate hooks.
+func (o *options) issued(u UUID, v Version) {
+	o.stats.issued.Add(1)
+	if o.guard != nil {
+		o.guard.check(u)
+	}
+	if o.metrics != nil {
+		o.metrics.Generated(v)
+	}
+	if o.generateHook != nil {
+		o.generateHook(u, v)
+	}
+}
+
+// issuedBatch reports a batch of minted UUIDs to the metrics and generate hooks.
+func (o *options) issuedBatch(uuids []UUID, v Version) {
+	o.stats.issued.Add(uint64(len(uuids)))
+	if o.guard != nil {
+		for _, u := range uuids {
+			o.guard.check(u)
+		}
+	}
+	if o.metrics != nil {
+		o.metrics.Batch(v, len(uuids))
+	}
+	if o.generateHook != nil {
+		for _, u := range uuids {
+			o.generateHook(u, v)
+		}
+	}
+}
+
+// MetricsHook receives generation events from a [Generator] or [Pool], for
+// exporting to a metrics system (see the uuidmetrics module). Methods are
+// called synchronously, some while the generator's lock is held; they must be
+// cheap and must not call back into the Generator or Pool.
+type MetricsHook interface {
+	// Generated is called after a single UUID of version v was issued.
+	Generated(v Version)
+	// Batch is called after a batch of n UUIDs of version v was issued.
+	Batch(v Version, n int)
+	// Refilled is called when a Pool refills its buffer for version v.
+	Refilled(v Version)
+	// ClockRollback is called when the wall clock is observed to have moved
+	// backwards by d since the previous V7 UUID.
+	ClockRollback(d time.Duration)
+}
+
+// clockRollback reports a clock reading d earlier than the previous one.
+func (o *options) clockRollback(d time.Duration) {
+	o.stats.rollbacks.Add(1)
+	if o.metrics != nil {
+		o.metrics.ClockRollback(d)
+	}
+}
+
+// WithMetrics installs a [MetricsHook] that observes generation events.
+func WithMetrics(m MetricsHook) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// WithV7Precision truncates V7 timestamps to multiples of d (e.g. 10ms or
+// 1s) and fills rand_a with random bits instead of sub-millisecond precision,
+// so public IDs reveal less about when they were created. UUIDs minted
+// within the same interval do not sort in creation order; timestamps still
+// never move backwards. Values of d below one millisecond keep the default
+// RFC 9562 Method 3 behavior.
+func WithV7Precision(d time.Duration) Option {
+	return func(o *options) {
+		o.precision = int64(d / time.Millisecond)
+	}
+}
+
+// V7Method selects how a [Generator] or [Pool] fills rand_a of V7 UUIDs to
+// keep them monotonic, per RFC 9562 Section 6.2.
+type V7Method uint8
+
+// V7 monotonicity methods.
+const (
+	// V7SubMillisecond stores the sub-millisecond fraction of the timestamp
+	// in rand_a and increments it when UUIDs are generated faster than the
+	// clock advances (Method 3). It is the default.
+	V7SubMillisecond V7Method = iota
+	// V7FixedCounter uses rand_a as a counter, seeded randomly with its
+	// leftmost bit cleared whenever the timestamp advances and incremented
+	// by one otherwise (Method 1, fixed-length dedicated counter).
+	V7FixedCounter
+	// V7RandomIncrement is like V7FixedCounter but increments the counter
+	// by a random amount from 1 to 16, so consecutive UUIDs are harder to
+	// guess (Method 2, monotonic random).
+	V7RandomIncrement
+)
+
+// WithV7Method selects how V7 UUIDs are kept monotonic within a millisecond,
+// to match the counter semantics of other implementations. Counter overflow
+// carries into the timestamp, as it does for the default method.
+// Combined with [WithV7Precision], the counter methods count per interval.
+func WithV7Method(m V7Method) Option {
+	return func(o *options) {
+		o.method = m
+	}
+}
+
+// WithV7CounterBits widens the monotonic V7 counter from the 12 bits of
+// rand_a to n bits, continuing into the leftmost n-12 bits of rand_b, so a
+// Generator or Pool can issue 2^n UUIDs per millisecond before the counter
+// overflows into the timestamp and pushes it ahead of the clock. The widened
+// bits are seeded randomly and leave 74-n random bits. n is clamped to the
+// range 12 to 42 recommended by RFC 9562 Section 6.2. It applies to the
+// default method, whose sub-millisecond fraction is extended by the counter
+// bits, and to the counter methods of [WithV7Method], but not to random
+// rand_a with [WithV7Precision].
+func WithV7CounterBits(n int) Option {
+	return func(o *options) {
+		o.extBits = uint8(min(max(n, 12), 42) - 12)
+	}
+}
+
+// WithDescendingV7 makes V7 UUIDs sort newest first: the timestamp bits
+// (unix_ts_ms and rand_a) are inverted, keeping the V7 layout, version, and
+// variant. Use it for append-mostly tables whose main access pattern is
+// "latest N rows". [ReverseV7] converts between descending and normal V7
+// UUIDs; decode timestamps only after converting.
+func WithDescendingV7() Option {
+	return func(o *options) {
+		o.descending = true
+	}
+}
+
+// WithRandReader makes a Generator or Pool read random bits from r instead
+// of crypto/rand, for a DRBG, a hardware RNG, or a seeded deterministic
+// reader in reproducible simulations. Generation panics if r returns an
+// error, rather than issuing UUIDs without randomness. r must be safe for
+// concurrent use; it may be called while the generator's lock is held.
+//
+// UUIDs are only as unpredictable and unique as r; the package-level
+// functions other than [NewV4FromReader] and [NewV7FromReader] always use
+// crypto/rand. For deterministic tests, prefer
+// [testing/cryptotest.SetGlobalRandom].
+func WithRandReader(r io.Reader) Option {
+	return func(o *options) {
+		o.rand = r
+	}
+}
+
+// WithClock makes a Generator or Pool read timestamps from clock instead of
+// [time.Now], for a mock clock in tests, a coarse cached clock, or an
+// NTP-disciplined clock. Monotonicity is preserved if clock moves
+// backwards, as it is for the wall clock. clock must be safe for concurrent
+// use; it is called with the generator's lock held, so readings are ordered
+// like the UUIDs they stamp.
+func WithClock(clock func() time.Time) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// RollbackPolicy selects how a [Generator] or [Pool] issues V7 UUIDs while
+// the clock reads earlier than it did for the previous one, after an NTP
+// step or a VM migration.
+type RollbackPolicy uint8
+
+// Clock rollback policies.
+const (
+	// RollbackClamp keeps incrementing from the last issued UUID, so UUIDs
+	// stay monotonic but their timestamps run ahead of the clock until it
+	// catches up. It is the default.
+	RollbackClamp RollbackPolicy = iota
+	// RollbackWait sleeps until the clock has caught up with the last
+	// reading, so timestamps stay accurate at the cost of latency. A large
+	// step blocks generation for as long as the step. It sleeps with the
+	// generator's lock held and needs a clock that advances in real time.
+	RollbackWait
+	// RollbackFail refuses to issue UUIDs until the clock has caught up:
+	// [Generator.TryNewV7], [Generator.TryFillV7],
+	// [Generator.NewV7BatchContext], and [Pool.TryNewV7] return a
+	// [*RollbackError], and the other V7 methods panic with one.
+	RollbackFail
+)
+
+// WithRollbackPolicy selects how V7 UUIDs are issued after the clock moves
+// backwards. Every rollback is reported to the [MetricsHook] installed with
+// [WithMetrics], whatever the policy.
+func WithRollbackPolicy(p RollbackPolicy) Option {
+	return func(o *options) {
+		o.rollback = p
+	}
+}
+
+// RollbackError is returned by the error-returning V7 methods, such as
+// [Generator.TryNewV7] and [Pool.TryNewV7], and is the panic value of the
+// other V7 methods, when the clock has moved backwards under [RollbackFail].
+type RollbackError struct {
+	Rollback time.Duration // how far the clock is behind its last reading
+}
+
+func (e *RollbackError) Error() string {
+	return fmt.Sprintf("uuid: clock moved backwards by %v", e.Rollback)
+}
+
+// nowNano returns the current Unix time in nanoseconds from the configured
+// clock.
+func (o *options) nowNano() int64 {
+	if o.clock != nil {
+		return o.clock().UnixNano()
+	}
+	return time.Now().UnixNano()
+}
+
+// WithNode sets the 48-bit node ID of V1, V2, and V6 UUIDs, such as a MAC address,
+// instead of a random one:
+//
+//	iface, _ := net.InterfaceByName("eth0")
+//	var node [6]byte
+//	copy(node[:], iface.HardwareAddr)
+//	gen := uuid.NewGenerator(uuid.WithNode(node))
+//
+// A MAC address identifies the generating host to anyone who sees the UUID.
+func WithNode(node [6]byte) Option {
+	return func(o *options) {
+		o.node = &node
+	}
+}
+
+// WithDuplicateGuard remembers the last window UUIDs issued by the Generator
+// or Pool and panics with a [*DuplicateError] if one is ever issued again
+// within that window. Duplicates cannot occur with a working entropy source;
+// the guard is a belt-and-braces check against a misconfigured or broken
+// one, at the cost of roughly 50 bytes of memory per remembered UUID and a
+// map lookup per UUID. A window below 1 disables the guard.
+func WithDuplicateGuard(window int) Option {
+	return func(o *options) {
+		if window < 1 {
+			o.guard = nil
+			return
+		}
+		o.guard = &dupGuard{
+			ring: make([]UUID, 0, window),
+			seen: make(map[UUID]struct{}, window),
+		}
+	}
+}
+
+// dupGuard remembers the most recently issued UUIDs for WithDuplicateGuard.
+type dupGuard struct {
+	mu   sync.Mutex
+	ring []UUID // issued UUIDs, oldest at pos once full
+	pos  int
+	seen map[UUID]struct{}
+}
+
+// check records u and panics if it is already among the remembered UUIDs.
+func (g *dupGuard) check(u UUID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, dup := g.seen[u]; dup {
+		panic(&DuplicateError{UUID: u})
+	}
+	if len(g.ring) < cap(g.ring) {
+		g.ring = append(g.ring, u)
+	} else {
+		delete(g.seen, g.ring[g.pos])
+		g.ring[g.pos] = u
+		g.pos = (g.pos + 1) % len(g.ring)
+	}
+	g.seen[u] = struct{}{}
+}
+
+// DuplicateError is the panic value of a Generator or Pool configured with
+// [WithDuplicateGuard] that is about to issue a UUID it has issued before.
+type DuplicateError struct {
+	UUID UUID // the repeated UUID
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("uuid: duplicate UUID %s issued; entropy source is broken", e.UUID)
+}
+
+// putV7 writes the counter extension ext into rand_b and then encodes seq
+// like the package-level putV7, followed by the inversion configured with
+// [WithDescendingV7], which also inverts ext.
+func (o *options) putV7(u *UUID, seq int64, ext uint64) {
+	if o.extBits > 0 {
+		mask := uint64(1)<<o.extBits - 1
+		if o.descending {
+			ext ^= mask
+		}
+		shift := 62 - o.extBits
+		b := binary.BigEndian.Uint64(u[8:])
+		binary.BigEndian.PutUint64(u[8:], b&^(mask<<shift)|ext<<shift)
+	}
+	putV7(u, seq)
+	if o.descending {
+		*u = invertTime(*u)
+	}
+}
+
+// readRandom fills b from crypto/rand or the reader configured with
+// [WithRandReader], reporting the read to the entropy hook if one is
+// installed. If the configured reader fails, it panics with an error
+// wrapping the reader's error.
+func (o *options) readRandom(b []byte) {
+	var start time.Time
+	if o.entropyHook != nil {
+		start = time.Now()
+	}
+	n, err := o.read(b)
+	if o.entropyHook != nil {
+		o.entropyHook(n, err, time.Since(start))
+	}
+	if err != nil {
+		panic(fmt.Errorf("uuid: reading random bytes: %w", err))
+	}
+}
+
+// read fills b from the configured entropy source. Passing b to an
+// arbitrary io.Reader would make it escape on every path, so a custom reader
+// fills a heap copy instead, keeping the default crypto/rand path
+// allocation-free as crypto/rand.Read itself does.
+func (o *options) read(b []byte) (int, error) {
+	if o.rand == nil {
+		return randRead(b)
+	}
+	buf := make([]byte, len(b))
+	n, err := io.ReadFull(o.rand, buf)
+	copy(b, buf)
+	return n, err
+}
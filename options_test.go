@@ -0,0 +1,630 @@
+package uuid
+
+import (
+	"errors"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"testing/synctest"
+	"time"
+)
+
+// entropyRecorder collects the byte counts reported to an EntropyHook.
+type entropyRecorder struct {
+	reads []int
+}
+
+func (r *entropyRecorder) hook(n int, err error, latency time.Duration) {
+	if err != nil {
+		panic(err)
+	}
+	if latency < 0 {
+		panic("negative latency")
+	}
+	r.reads = append(r.reads, n)
+}
+
+func TestEntropyHookGenerator(t *testing.T) {
+	var rec entropyRecorder
+	gen := NewGenerator(WithEntropyHook(rec.hook))
+
+	gen.NewV7()
+	gen.NewV7Batch(10)
+
+	want := []int{8, 80}
+	if len(rec.reads) != len(want) {
+		t.Fatalf("hook called %d times, want %d", len(rec.reads), len(want))
+	}
+	for i := range want {
+		if rec.reads[i] != want[i] {
+			t.Errorf("read[%d] = %d bytes, want %d", i, rec.reads[i], want[i])
+		}
+	}
+}
+
+func TestEntropyHookPool(t *testing.T) {
+	var rec entropyRecorder
+	pool := NewPool(WithEntropyHook(rec.hook))
+
+	for range poolSize + 1 {
+		pool.NewV4()
+	}
+	pool.NewV7()
+
+	want := []int{poolSize * 16, poolSize * 16, poolSize * 8}
+	if len(rec.reads) != len(want) {
+		t.Fatalf("hook called %d times, want %d", len(rec.reads), len(want))
+	}
+	for i := range want {
+		if rec.reads[i] != want[i] {
+			t.Errorf("read[%d] = %d bytes, want %d", i, rec.reads[i], want[i])
+		}
+	}
+}
+
+func TestNoEntropyHook(t *testing.T) {
+	gen := NewGenerator()
+	if u := gen.NewV7(); u.Version() != V7 {
+		t.Errorf("NewV7().Version() = %v, want V7", u.Version())
+	}
+}
+
+func TestV7PrecisionGenerator(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator(WithV7Precision(time.Second))
+		time.Sleep(1500 * time.Millisecond)
+		want := time.Now().Truncate(time.Second)
+
+		seen := make(map[UUID]bool)
+		for _, u := range append(gen.NewV7Batch(50), gen.NewV7()) {
+			if u.Version() != V7 {
+				t.Errorf("Version() = %v, want V7", u.Version())
+			}
+			if u.Variant() != VariantRFC9562 {
+				t.Errorf("Variant() = %v, want RFC9562", u.Variant())
+			}
+			if got := u.Time(); !got.Equal(want) {
+				t.Errorf("Time() = %v, want %v", got, want)
+			}
+			if seen[u] {
+				t.Fatalf("duplicate UUID: %s", u)
+			}
+			seen[u] = true
+		}
+	})
+}
+
+func TestV7PrecisionPool(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var rec entropyRecorder
+		pool := NewPool(WithV7Precision(10*time.Millisecond), WithEntropyHook(rec.hook))
+		time.Sleep(25 * time.Millisecond)
+		want := time.Now().Truncate(10 * time.Millisecond)
+
+		for range poolSize + 1 {
+			if got := pool.NewV7().Time(); !got.Equal(want) {
+				t.Fatalf("Time() = %v, want %v", got, want)
+			}
+		}
+		if len(rec.reads) != 2 || rec.reads[0] != poolSize*10 {
+			t.Errorf("entropy reads = %v, want two reads of %d bytes", rec.reads, poolSize*10)
+		}
+	})
+}
+
+func TestV7PrecisionBelowMillisecond(t *testing.T) {
+	o := newOptions([]Option{WithV7Precision(time.Microsecond)})
+	if o.precision != 0 {
+		t.Errorf("precision = %d, want 0 (Method 3)", o.precision)
+	}
+}
+
+func TestV7NextCoarseClampsTimestamp(t *testing.T) {
+	o := newOptions([]Option{WithV7Precision(time.Second)})
+	last := int64(5000)<<12 | 0x123
+	// Clock moved backwards: timestamp is clamped, rand_a is kept.
+	if got, _ := o.v7Next(int64(4000)<<12|0xabc, 0, last, 0); got != int64(5000)<<12|0xabc {
+		t.Errorf("v7Next(backwards) = %#x, want %#x", got, int64(5000)<<12|0xabc)
+	}
+	// Same interval: no increment, rand_a is kept.
+	if got, _ := o.v7Next(int64(5000)<<12|0x001, 0, last, 0); got != int64(5000)<<12|0x001 {
+		t.Errorf("v7Next(same interval) = %#x, want %#x", got, int64(5000)<<12|0x001)
+	}
+}
+
+// metricsRecorder is a MetricsHook that records every event it receives.
+type metricsRecorder struct {
+	generated map[Version]int
+	batches   []int
+	refills   map[Version]int
+	rollbacks []time.Duration
+}
+
+func newMetricsRecorder() *metricsRecorder {
+	return &metricsRecorder{generated: map[Version]int{}, refills: map[Version]int{}}
+}
+
+func (m *metricsRecorder) Generated(v Version)           { m.generated[v]++ }
+func (m *metricsRecorder) Batch(_ Version, n int)        { m.batches = append(m.batches, n) }
+func (m *metricsRecorder) Refilled(v Version)            { m.refills[v]++ }
+func (m *metricsRecorder) ClockRollback(d time.Duration) { m.rollbacks = append(m.rollbacks, d) }
+
+func TestMetricsGenerator(t *testing.T) {
+	m := newMetricsRecorder()
+	gen := NewGenerator(WithMetrics(m))
+	gen.NewV7()
+	gen.NewV7()
+	gen.NewV7Batch(5)
+
+	if m.generated[V7] != 2 {
+		t.Errorf("Generated(V7) called %d times, want 2", m.generated[V7])
+	}
+	if len(m.batches) != 1 || m.batches[0] != 5 {
+		t.Errorf("Batch sizes = %v, want [5]", m.batches)
+	}
+}
+
+func TestMetricsPool(t *testing.T) {
+	m := newMetricsRecorder()
+	pool := NewPool(WithMetrics(m))
+	for range poolSize + 1 {
+		pool.NewV4()
+	}
+	pool.NewV7()
+
+	if m.generated[V4] != poolSize+1 || m.generated[V7] != 1 {
+		t.Errorf("Generated = %v, want V4:%d V7:1", m.generated, poolSize+1)
+	}
+	if m.refills[V4] != 2 || m.refills[V7] != 1 {
+		t.Errorf("Refilled = %v, want V4:2 V7:1", m.refills)
+	}
+}
+
+func TestMetricsClockRollback(t *testing.T) {
+	m := newMetricsRecorder()
+	o := newOptions([]Option{WithMetrics(m)})
+	var st v7State
+	var rnd [10]byte
+	step := func(nano int64) int64 {
+		nano, _ = st.observe(&o, nano)
+		seq, _ := st.next(&o, nano, rnd[:])
+		return seq
+	}
+
+	first := step(10 * nanoPerMilli)
+	second := step(7 * nanoPerMilli)
+	step(8 * nanoPerMilli)
+
+	if second <= first {
+		t.Errorf("sequence not monotonic across rollback: %#x <= %#x", second, first)
+	}
+	if len(m.rollbacks) != 1 || m.rollbacks[0] != 3*time.Millisecond {
+		t.Errorf("rollbacks = %v, want [3ms]", m.rollbacks)
+	}
+}
+
+func TestRollbackWait(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var offset time.Duration
+		m := newMetricsRecorder()
+		gen := NewGenerator(
+			WithClock(func() time.Time { return time.Now().Add(offset) }),
+			WithRollbackPolicy(RollbackWait),
+			WithMetrics(m),
+		)
+		first := gen.NewV7()
+		offset = -time.Second
+		start := time.Now()
+		second := gen.NewV7()
+
+		if waited := time.Since(start); waited != time.Second {
+			t.Errorf("waited %v, want 1s", waited)
+		}
+		if !second.Time().Equal(first.Time()) || Compare(second, first) <= 0 {
+			t.Errorf("after waiting: %s then %s, want same ms and increasing", first, second)
+		}
+		if len(m.rollbacks) != 1 || m.rollbacks[0] != time.Second {
+			t.Errorf("rollbacks = %v, want [1s]", m.rollbacks)
+		}
+	})
+}
+
+func isRollback(err error) bool {
+	_, ok := errors.AsType[*RollbackError](err)
+	return ok
+}
+
+func TestRollbackFail(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var offset time.Duration
+		opts := []Option{
+			WithClock(func() time.Time { return time.Now().Add(offset) }),
+			WithRollbackPolicy(RollbackFail),
+		}
+		gen, pool := NewGenerator(opts...), NewPool(opts...)
+		gen.NewV7()
+		pool.NewV7()
+		offset = -time.Second
+
+		_, err := gen.TryNewV7()
+		rerr, ok := errors.AsType[*RollbackError](err)
+		if !ok || rerr.Rollback != time.Second {
+			t.Fatalf("TryNewV7() error = %v, want *RollbackError of 1s", err)
+		}
+		if want := "uuid: clock moved backwards by 1s"; err.Error() != want {
+			t.Errorf("Error() = %q, want %q", err.Error(), want)
+		}
+		if _, err := pool.TryNewV7(); !isRollback(err) {
+			t.Errorf("Pool.TryNewV7() error = %v, want *RollbackError", err)
+		}
+		dst := make([]UUID, 2)
+		if err := gen.TryFillV7(dst); !isRollback(err) || dst[0] != Nil {
+			t.Errorf("TryFillV7() error = %v, dst = %v, want *RollbackError and dst unchanged", err, dst)
+		}
+		got, err := gen.NewV7BatchContext(t.Context(), 3)
+		if !isRollback(err) || len(got) != 0 {
+			t.Errorf("NewV7BatchContext() = %v, %v, want no UUIDs and *RollbackError", got, err)
+		}
+		for name, f := range map[string]func(){
+			"Generator.NewV7":      func() { gen.NewV7() },
+			"Generator.NewV7Batch": func() { gen.NewV7Batch(2) },
+			"Generator.FillV7":     func() { gen.FillV7(dst) },
+			"Pool.NewV7":           func() { pool.NewV7() },
+		} {
+			func() {
+				defer func() {
+					if _, ok := recover().(*RollbackError); !ok {
+						t.Errorf("%s did not panic with *RollbackError", name)
+					}
+				}()
+				f()
+			}()
+		}
+
+		// Generation resumes once the clock has caught up.
+		time.Sleep(time.Second)
+		if _, err := gen.TryNewV7(); err != nil {
+			t.Errorf("TryNewV7() after catching up: %v", err)
+		}
+		if _, err := pool.TryNewV7(); err != nil {
+			t.Errorf("Pool.TryNewV7() after catching up: %v", err)
+		}
+		if err := gen.TryFillV7(dst); err != nil || dst[0] == Nil || Compare(dst[0], dst[1]) >= 0 {
+			t.Errorf("TryFillV7() after catching up = %v, %v", dst, err)
+		}
+	})
+}
+
+// TestRollbackFailConcurrent checks that a clock which never moves
+// backwards produces no rollbacks, however goroutines interleave between
+// reading the clock and taking the generator's lock.
+func TestRollbackFailConcurrent(t *testing.T) {
+	var now atomic.Int64
+	clock := func() time.Time {
+		t := time.Unix(0, now.Add(1))
+		runtime.Gosched() // widen the window between reading and locking
+		return t
+	}
+	gen := NewGenerator(
+		WithClock(clock),
+		WithRollbackPolicy(RollbackFail),
+	)
+	var wg sync.WaitGroup
+	var errs atomic.Int64
+	for range 16 {
+		wg.Go(func() {
+			dst := make([]UUID, 4)
+			for range 500 {
+				if _, err := gen.TryNewV7(); err != nil {
+					errs.Add(1)
+				}
+				if err := gen.TryFillV7(dst); err != nil {
+					errs.Add(1)
+				}
+				gen.NewV1()
+				gen.NewV6()
+				gen.NewV2(DomainPerson, 0)
+			}
+		})
+	}
+	wg.Wait()
+	if n := errs.Load(); n != 0 {
+		t.Errorf("%d RollbackErrors from a forward-only clock, want 0", n)
+	}
+	if n := gen.Stats().Rollbacks; n != 0 {
+		t.Errorf("Stats().Rollbacks = %d, want 0", n)
+	}
+}
+
+// hookRecorder collects the UUIDs reported to a GenerateHook.
+type hookRecorder struct {
+	uuids    []UUID
+	versions []Version
+}
+
+func (r *hookRecorder) hook(u UUID, v Version) {
+	r.uuids = append(r.uuids, u)
+	r.versions = append(r.versions, v)
+}
+
+func TestGenerateHookGenerator(t *testing.T) {
+	var rec hookRecorder
+	gen := NewGenerator(WithGenerateHook(rec.hook))
+	want := append([]UUID{gen.NewV7()}, gen.NewV7Batch(3)...)
+
+	if len(rec.uuids) != len(want) {
+		t.Fatalf("hook saw %d UUIDs, want %d", len(rec.uuids), len(want))
+	}
+	for i := range want {
+		if rec.uuids[i] != want[i] || rec.versions[i] != V7 {
+			t.Errorf("hook[%d] = (%s, %v), want (%s, V7)", i, rec.uuids[i], rec.versions[i], want[i])
+		}
+	}
+}
+
+func TestGenerateHookPool(t *testing.T) {
+	var rec hookRecorder
+	pool := NewPool(WithGenerateHook(rec.hook))
+	a := pool.NewV4()
+	b := pool.NewV7()
+
+	if len(rec.uuids) != 2 || rec.uuids[0] != a || rec.uuids[1] != b {
+		t.Fatalf("hook saw %v, want [%s %s]", rec.uuids, a, b)
+	}
+	if rec.versions[0] != V4 || rec.versions[1] != V7 {
+		t.Errorf("hook versions = %v, want [V4 V7]", rec.versions)
+	}
+}
+
+func TestSetGenerateHook(t *testing.T) {
+	var rec hookRecorder
+	SetGenerateHook(rec.hook)
+	t.Cleanup(func() { SetGenerateHook(nil) })
+
+	want := append([]UUID{NewV4(), NewV7()}, NewV4Batch(2)...)
+	if len(rec.uuids) != len(want) {
+		t.Fatalf("hook saw %d UUIDs, want %d", len(rec.uuids), len(want))
+	}
+	for i := range want {
+		if rec.uuids[i] != want[i] {
+			t.Errorf("hook[%d] = %s, want %s", i, rec.uuids[i], want[i])
+		}
+	}
+
+	SetGenerateHook(nil)
+	NewV4()
+	if len(rec.uuids) != len(want) {
+		t.Errorf("hook still called after SetGenerateHook(nil)")
+	}
+}
+
+func TestDescendingV7(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		start := time.Now()
+		gen := NewGenerator(WithDescendingV7())
+		pool := NewPool(WithDescendingV7())
+		// Ordering holds per generator, not across independent ones.
+		var genIDs, poolIDs []UUID
+		for range 3 {
+			genIDs = append(genIDs, gen.NewV7())
+			genIDs = append(genIDs, gen.NewV7Batch(2)...)
+			poolIDs = append(poolIDs, pool.NewV7(), pool.NewV7())
+			time.Sleep(time.Millisecond)
+		}
+		descending := func(a, b UUID) int { return Compare(b, a) }
+		if !slices.IsSortedFunc(genIDs, descending) || !slices.IsSortedFunc(poolIDs, descending) {
+			t.Error("descending V7 UUIDs are not in reverse creation order")
+		}
+		for _, u := range slices.Concat(genIDs, poolIDs) {
+			if u.Version() != V7 || u.Variant() != VariantRFC9562 {
+				t.Fatalf("%s: version %v, variant %v", u, u.Version(), u.Variant())
+			}
+			if got := ReverseV7(u).Time(); got.Before(start) || got.After(time.Now()) {
+				t.Errorf("ReverseV7(%s).Time() = %v, want between %v and %v", u, got, start, time.Now())
+			}
+		}
+	})
+}
+
+func TestReverseV7(t *testing.T) {
+	u := MustParse("018f86ba-c2bb-7abc-9123-456789abcdef")
+	r := ReverseV7(u)
+	if r.String() != "fe707945-3d44-7543-9123-456789abcdef" {
+		t.Errorf("ReverseV7() = %s", r)
+	}
+	if ReverseV7(r) != u {
+		t.Errorf("ReverseV7(ReverseV7(u)) = %s, want %s", ReverseV7(r), u)
+	}
+}
+
+func TestDuplicateGuard(t *testing.T) {
+	o := newOptions([]Option{WithDuplicateGuard(2)})
+	a, b, c := MustParse("00000000-0000-4000-8000-00000000000a"),
+		MustParse("00000000-0000-4000-8000-00000000000b"),
+		MustParse("00000000-0000-4000-8000-00000000000c")
+
+	// a has left the window by the time it reappears.
+	o.issuedBatch([]UUID{a, b, c}, V4)
+	o.issued(a, V4)
+
+	defer func() {
+		err, ok := recover().(*DuplicateError)
+		if !ok || err.UUID != c {
+			t.Fatalf("recover() = %v, want *DuplicateError for %s", err, c)
+		}
+		if want := "uuid: duplicate UUID " + c.String() + " issued; entropy source is broken"; err.Error() != want {
+			t.Errorf("Error() = %q, want %q", err.Error(), want)
+		}
+	}()
+	o.issued(c, V4)
+	t.Fatal("duplicate within the window did not panic")
+}
+
+func TestDuplicateGuardGenerators(t *testing.T) {
+	gen := NewGenerator(WithDuplicateGuard(100))
+	pool := NewPool(WithDuplicateGuard(100))
+	gen.NewV7Batch(50)
+	for range poolSize + 1 {
+		gen.NewV7()
+		pool.NewV4()
+		pool.NewV7()
+	}
+	if o := newOptions([]Option{WithDuplicateGuard(10), WithDuplicateGuard(0)}); o.guard != nil {
+		t.Error("WithDuplicateGuard(0) did not disable the guard")
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+	gen := NewGenerator(WithClock(clock))
+	pool := NewPool(WithClock(clock))
+
+	for _, u := range append(gen.NewV7Batch(3), gen.NewV7(), pool.NewV7()) {
+		if !u.Time().Equal(now) {
+			t.Errorf("%s: Time() = %v, want %v", u, u.Time(), now)
+		}
+	}
+	if got, _ := gen.NewV1().TimeOK(); !got.Equal(now) {
+		t.Errorf("NewV1() time = %v, want %v", got, now)
+	}
+
+	// A clock moving backwards does not break monotonicity.
+	last := gen.NewV7()
+	now = now.Add(-time.Hour)
+	if next := gen.NewV7(); Compare(next, last) <= 0 {
+		t.Errorf("NewV7() after clock rollback = %s, want > %s", next, last)
+	}
+}
+
+func TestWithRandReader(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	run := func() []UUID {
+		var seed [32]byte
+		opts := []Option{WithRandReader(rand.NewChaCha8(seed)), WithClock(func() time.Time { return now })}
+		gen, pool := NewGenerator(opts...), NewPool(opts...)
+		return append(gen.NewV7Batch(2), gen.NewV7(), gen.NewV1(), pool.NewV4(), pool.NewV7())
+	}
+	a, b := run(), run()
+	if !slices.Equal(a, b) {
+		t.Errorf("same seed and clock gave different UUIDs:\n%v\n%v", a, b)
+	}
+	if a[4].Version() != V4 || a[4] == a[5] {
+		t.Errorf("pool UUIDs = %s, %s", a[4], a[5])
+	}
+}
+
+func TestWithRandReaderError(t *testing.T) {
+	var hookErr error
+	offline := errors.New("rng offline")
+	gen := NewGenerator(
+		WithRandReader(iotest.ErrReader(offline)),
+		WithEntropyHook(func(_ int, err error, _ time.Duration) { hookErr = err }),
+	)
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, offline) || err.Error() != "uuid: reading random bytes: rng offline" {
+			t.Errorf("recover() = %v, want an error wrapping %v", err, offline)
+		}
+		if hookErr == nil {
+			t.Error("entropy hook did not see the error")
+		}
+	}()
+	gen.NewV7()
+	t.Error("NewV7() with a failing reader did not panic")
+}
+
+func TestV7Methods(t *testing.T) {
+	tests := []struct {
+		method  V7Method
+		maxStep int
+	}{
+		{V7FixedCounter, 1},
+		{V7RandomIncrement, 16},
+	}
+	for _, tt := range tests {
+		synctest.Test(t, func(t *testing.T) {
+			gen := NewGenerator(WithV7Method(tt.method))
+			pool := NewPool(WithV7Method(tt.method))
+			for _, ids := range [][]UUID{append(gen.NewV7Batch(50), gen.NewV7()), {pool.NewV7(), pool.NewV7(), pool.NewV7()}} {
+				if ids[0].RandA() >= 0x800 {
+					t.Errorf("method %d: seeded counter %#x has its leftmost bit set", tt.method, ids[0].RandA())
+				}
+				for i := 1; i < len(ids); i++ {
+					step := int(ids[i].RandA()) - int(ids[i-1].RandA())
+					if ids[i].Time() != ids[0].Time() || step < 1 || step > tt.maxStep {
+						t.Fatalf("method %d: counter step %d in the same millisecond, want 1 to %d", tt.method, step, tt.maxStep)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestV7NextCounterMethods(t *testing.T) {
+	last := int64(5000)<<12 | 0x7ff
+	fixed := newOptions([]Option{WithV7Method(V7FixedCounter)})
+	// A new millisecond reseeds; a counter overflow carries into the timestamp.
+	if got, _ := fixed.v7Next(int64(5001)<<12|0x123, 0, last, 0); got != int64(5001)<<12|0x123 {
+		t.Errorf("v7Next(new ms) = %#x, want %#x", got, int64(5001)<<12|0x123)
+	}
+	if got, _ := fixed.v7Next(int64(5000)<<12|0x123, 0, int64(5000)<<12|0xfff, 0); got != int64(5001)<<12 {
+		t.Errorf("v7Next(overflow) = %#x, want %#x", got, int64(5001)<<12)
+	}
+	random := newOptions([]Option{WithV7Method(V7RandomIncrement)})
+	if got, _ := random.v7Next(int64(4999)<<12|0x125, 0, last, 0); got != last+1+5 {
+		t.Errorf("v7Next(backwards) = %#x, want %#x", got, last+1+5)
+	}
+}
+
+func TestV7CounterBits(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		now := time.Now()
+		// 10000 UUIDs in one clock reading overflow 12 bits, but not 26.
+		narrow := NewGenerator().NewV7Batch(10000)
+		wide := NewGenerator(WithV7CounterBits(26)).NewV7Batch(10000)
+		if last := narrow[len(narrow)-1]; !last.Time().After(now) {
+			t.Errorf("12-bit counter: last Time() = %v, want after %v", last.Time(), now)
+		}
+		if last := wide[len(wide)-1]; !last.Time().Equal(now.Truncate(time.Millisecond)) {
+			t.Errorf("26-bit counter: last Time() = %v, want %v", last.Time(), now)
+		}
+		if !slices.IsSortedFunc(wide, Compare) || wide[0].Variant() != VariantRFC9562 {
+			t.Error("26-bit counter UUIDs are not sorted RFC 9562 V7 UUIDs")
+		}
+
+		// A 42-bit Method 1 counter spans rand_a and 30 bits of rand_b.
+		counter := func(u UUID) uint64 { return uint64(u.RandA())<<30 | u.RandB()>>32 }
+		ids := NewGenerator(WithV7CounterBits(42), WithV7Method(V7FixedCounter)).NewV7Batch(3)
+		for i := 1; i < len(ids); i++ {
+			if counter(ids[i]) != counter(ids[i-1])+1 {
+				t.Errorf("counter %#x follows %#x, want increment by 1", counter(ids[i]), counter(ids[i-1]))
+			}
+		}
+
+		desc := NewPool(WithV7CounterBits(20), WithDescendingV7())
+		if a, b := desc.NewV7(), desc.NewV7(); Compare(a, b) <= 0 {
+			t.Errorf("descending with counter bits: %s then %s, want decreasing", a, b)
+		}
+	})
+}
+
+func TestV7CounterBitsClamped(t *testing.T) {
+	for n, want := range map[int]uint8{0: 0, 12: 0, 26: 14, 42: 30, 100: 30} {
+		if got := newOptions([]Option{WithV7CounterBits(n)}).extBits; got != want {
+			t.Errorf("WithV7CounterBits(%d): extBits = %d, want %d", n, got, want)
+		}
+	}
+	o := newOptions([]Option{WithV7CounterBits(14)})
+	// Same sequence: a larger extension is kept, a smaller one incremented with carry.
+	if seq, ext := o.v7Next(100, 2, 100, 1); seq != 100 || ext != 2 {
+		t.Errorf("v7Next(larger ext) = %d, %d, want 100, 2", seq, ext)
+	}
+	if seq, ext := o.v7Next(100, 0, 100, 3); seq != 101 || ext != 0 {
+		t.Errorf("v7Next(ext overflow) = %d, %d, want 101, 0", seq, ext)
+	}
+}
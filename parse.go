@@ -1,6 +1,11 @@
 package uuid
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
 
 // xvalues maps hex character bytes to their values; 0xff marks invalid.
 var xvalues = [256]byte{
@@ -45,16 +50,16 @@ var hexOffsets = [16]int{
 // For URN, braced, or compact (32-hex) forms, use [ParseLenient].
 func Parse(s string) (UUID, error) {
 	if len(s) != 36 {
-		return Nil, &ParseError{Input: s, Msg: "expected 36-character hyphenated format"}
+		return Nil, &ParseError{Input: s, Msg: "expected 36-character hyphenated format", canonical: true}
 	}
 	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
-		return Nil, &ParseError{Input: s, Msg: "expected hyphens at positions 8, 13, 18, 23"}
+		return Nil, &ParseError{Input: s, Msg: "expected hyphens at positions 8, 13, 18, 23", canonical: true}
 	}
 	var u UUID
 	for i, x := range hexOffsets {
 		v, ok := xtob(s[x], s[x+1])
 		if !ok {
-			return Nil, &ParseError{Input: s, Msg: "invalid hex character"}
+			return Nil, &ParseError{Input: s, Msg: "invalid hex character", canonical: true}
 		}
 		u[i] = v
 	}
@@ -113,14 +118,14 @@ func FromBytes(b []byte) (UUID, error) {
 // skipping the hyphens at the standard positions.
 func parseHex(s string, offset int) (UUID, error) {
 	if s[offset+8] != '-' || s[offset+13] != '-' || s[offset+18] != '-' || s[offset+23] != '-' {
-		return Nil, &ParseError{Input: s, Msg: "missing or misplaced hyphens"}
+		return Nil, &ParseError{Input: s, Msg: "missing or misplaced hyphens", canonical: offset == 0}
 	}
 	var u UUID
 	for i, x := range hexOffsets {
 		x += offset
 		v, ok := xtob(s[x], s[x+1])
 		if !ok {
-			return Nil, &ParseError{Input: s, Msg: "invalid hex character"}
+			return Nil, &ParseError{Input: s, Msg: "invalid hex character", canonical: offset == 0}
 		}
 		u[i] = v
 	}
@@ -164,12 +169,92 @@ func parseHexBytes(u *UUID, b []byte, offset int) bool {
 type ParseError struct {
 	Input string // the string that failed to parse
 	Msg   string // description of the problem
+
+	// canonical reports that Input was checked against the 36-character
+	// hyphenated form, so Pretty can point at the offending character.
+	canonical bool
 }
 
 func (e *ParseError) Error() string {
 	return fmt.Sprintf("uuid: parsing %q: %s", e.Input, e.Msg)
 }
 
+// Pretty renders the error for humans, as in CLI output or API error
+// messages: the error message, the quoted input with a caret under the first
+// character that does not fit the standard 36-character form, and a hint.
+//
+//	uuid: parsing "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}": expected 36-character hyphenated format
+//	  "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"
+//	   ^
+//	  hint: this is a braced UUID; did you mean to use ParseLenient?
+//
+// The caret and hint are only rendered for errors from parsers of the
+// 36-character form: [Parse], [UUID.UnmarshalText], and [ParseLenient] when
+// given 36 characters. For all other errors, such as those from [ParseBase58]
+// or [ParseVersionName], Pretty returns the plain error message.
+func (e *ParseError) Pretty() string {
+	if !e.canonical {
+		return e.Error()
+	}
+	pos := canonicalErrorPos(e.Input)
+	// QuoteToASCII keeps every rendered character one column wide.
+	col := len(strconv.QuoteToASCII(e.Input[:pos])) - 1
+	return fmt.Sprintf("%s\n  %s\n  %s^\n  hint: %s",
+		e.Error(), strconv.QuoteToASCII(e.Input), strings.Repeat(" ", col), parseHint(e.Input, pos))
+}
+
+// canonicalErrorPos returns the byte offset of the first character of s that
+// does not fit the 36-character hyphenated form, or the offset where the
+// input ends too early or should have ended.
+func canonicalErrorPos(s string) int {
+	for i := range min(len(s), 36) {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if s[i] != '-' {
+				return i
+			}
+		} else if xvalues[s[i]] == 0xff {
+			return i
+		}
+	}
+	return min(len(s), 36)
+}
+
+// parseHint suggests a fix for s, whose first unexpected character is at pos.
+func parseHint(s string, pos int) string {
+	if _, err := ParseLenient(s); err == nil {
+		form := "compact"
+		switch len(s) {
+		case 38:
+			form = "braced"
+		case 45:
+			form = "URN"
+		case 36:
+			return "this UUID is valid; parse it with Parse"
+		}
+		return "this is a " + form + " UUID; did you mean to use ParseLenient?"
+	}
+	if len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:") {
+		if _, err := Parse(s[9:]); err == nil {
+			return `this is a URN UUID; ParseLenient accepts it with a lowercase "urn:uuid:" prefix`
+		}
+	}
+	if t := strings.TrimSpace(s); t != s {
+		if _, err := ParseLenient(t); err == nil {
+			return "remove the surrounding whitespace"
+		}
+	}
+	switch {
+	case pos == len(s):
+		return fmt.Sprintf("input ends after %d characters; a UUID has 36", len(s))
+	case pos == 36:
+		return fmt.Sprintf("input has %d characters; a UUID has 36", len(s))
+	case pos == 8 || pos == 13 || pos == 18 || pos == 23:
+		return "expected '-' here; groups have 8, 4, 4, 4, and 12 hex digits"
+	}
+	r, _ := utf8.DecodeRuneInString(s[pos:])
+	return fmt.Sprintf("%s is not a hex digit", strconv.QuoteRuneToASCII(r))
+}
+
 // LengthError is returned when the input has an unexpected byte length.
 //
 // Use [errors.AsType] to check for this error:
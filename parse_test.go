@@ -83,6 +83,77 @@ func TestParseErrorMessage(t *testing.T) {
 	}
 }
 
+func TestParseErrorPretty(t *testing.T) {
+	tests := []struct {
+		input string
+		caret int // column of the caret within the quoted input
+		hint  string
+	}{
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", 1, "this is a braced UUID; did you mean to use ParseLenient?"},
+		{"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", 1, "this is a URN UUID; did you mean to use ParseLenient?"},
+		{"6ba7b8109dad11d180b400c04fd430c8", 9, "this is a compact UUID; did you mean to use ParseLenient?"},
+		{" 6ba7b810-9dad-11d1-80b4-00c04fd430c8\n", 1, "remove the surrounding whitespace"},
+		{"6ba7b810-9dad-11d1-80b4", 24, "input ends after 23 characters; a UUID has 36"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8a", 37, "input has 37 characters; a UUID has 36"},
+		{"6ba7b810-9dad-11d1-80b4_00c04fd430c8", 24, "expected '-' here; groups have 8, 4, 4, 4, and 12 hex digits"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cg", 36, "'g' is not a hex digit"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd43ü", 34, "'\\u00fc' is not a hex digit"},
+		{"ä6ba7b810-9dad-11d1-80b4-00c04fd430c", 1, "'\\u00e4' is not a hex digit"},
+		{"", 1, "input ends after 0 characters; a UUID has 36"},
+		{"URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8", 1, `this is a URN UUID; ParseLenient accepts it with a lowercase "urn:uuid:" prefix`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		perr, ok := errors.AsType[*ParseError](err)
+		if !ok {
+			t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.input, err)
+		}
+		lines := strings.Split(perr.Pretty(), "\n")
+		if len(lines) != 4 || lines[0] != perr.Error() {
+			t.Fatalf("Pretty(%q) = %q", tt.input, perr.Pretty())
+		}
+		if want := "  " + strings.Repeat(" ", tt.caret) + "^"; lines[2] != want {
+			t.Errorf("Pretty(%q) caret line = %q, want %q", tt.input, lines[2], want)
+		}
+		if want := "  hint: " + tt.hint; lines[3] != want {
+			t.Errorf("Pretty(%q) hint = %q, want %q", tt.input, lines[3], want)
+		}
+	}
+
+	valid := &ParseError{Input: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Msg: "rejected elsewhere", canonical: true}
+	if !strings.HasSuffix(valid.Pretty(), "hint: this UUID is valid; parse it with Parse") {
+		t.Errorf("Pretty(valid) = %q", valid.Pretty())
+	}
+
+	var u UUID
+	if err := u.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430cg")); !strings.HasSuffix(err.(*ParseError).Pretty(), "hint: 'g' is not a hex digit") {
+		t.Errorf("UnmarshalText Pretty = %q", err.(*ParseError).Pretty())
+	}
+	if _, err := ParseLenient("6ba7b810-9dad-11d1-80b4_00c04fd430c8"); !strings.Contains(err.(*ParseError).Pretty(), "hint: expected '-' here") {
+		t.Errorf("ParseLenient Pretty = %q", err.(*ParseError).Pretty())
+	}
+}
+
+func TestParseErrorPrettyOtherForms(t *testing.T) {
+	// Errors from non-canonical parsers must not be annotated as if the
+	// input were a 36-character UUID.
+	for _, parse := range []func() error{
+		func() error { _, err := ParseBase58("6ba7b810"); return err },
+		func() error { _, err := ParseVersionName("v99"); return err },
+		func() error { _, err := ParseLenient("{6ba7b810-9dad-11d1-80b4-00c04fd430c8)"); return err },
+		func() error { _, err := ParseLenient("6ba7b8109dad11d180b400c04fd430cg"); return err },
+		func() error { _, err := ParseLenient("6ba7b810"); return err },
+	} {
+		perr, ok := errors.AsType[*ParseError](parse())
+		if !ok {
+			t.Fatalf("error is not a *ParseError")
+		}
+		if got := perr.Pretty(); got != perr.Error() {
+			t.Errorf("Pretty() = %q, want plain %q", got, perr.Error())
+		}
+	}
+}
+
 func TestLengthErrorMessage(t *testing.T) {
 	_, err := FromBytes([]byte{1, 2})
 	msg := err.Error()
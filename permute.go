@@ -0,0 +1,27 @@
+package uuid
+
+import "crypto/aes"
+
+// Permute maps u to a pseudorandom position in the 128-bit space under key,
+// using one AES-128 block encryption. The mapping is a bijection, reversed
+// by [Unpermute], and its outputs are uniformly distributed regardless of
+// how u was generated, so a fixed range of permuted values is an unbiased,
+// repeatable sample across tenants and time periods:
+//
+//	p := uuid.Permute(id, key)
+//	inSample := p[0] < 3 // ~1.2% of all entities, always the same ones
+//
+// The result does not carry the version and variant bits; it is a sampling
+// or bucketing key, not an identifier. Without key it cannot be mapped back.
+func Permute(u UUID, key [16]byte) UUID {
+	c, _ := aes.NewCipher(key[:]) // cannot fail for a 16-byte key
+	c.Encrypt(u[:], u[:])
+	return u
+}
+
+// Unpermute reverses [Permute] under the same key.
+func Unpermute(p UUID, key [16]byte) UUID {
+	c, _ := aes.NewCipher(key[:]) // cannot fail for a 16-byte key
+	c.Decrypt(p[:], p[:])
+	return p
+}
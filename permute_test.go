@@ -0,0 +1,38 @@
+package uuid
+
+import "testing"
+
+func TestPermute(t *testing.T) {
+	// FIPS 197 Appendix C.1 AES-128 test vector.
+	key := [16]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	u := MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	p := Permute(u, key)
+	if p.String() != "69c4e0d8-6a7b-0430-d8cd-b78070b4c55a" {
+		t.Errorf("Permute() = %s", p)
+	}
+	if got := Unpermute(p, key); got != u {
+		t.Errorf("Unpermute(Permute(u)) = %s, want %s", got, u)
+	}
+
+	other := key
+	other[0] ^= 1
+	if Permute(u, other) == p {
+		t.Error("Permute() does not depend on the key")
+	}
+}
+
+func TestPermuteSample(t *testing.T) {
+	var key [16]byte
+	gen := NewGenerator()
+	// Consecutive V7 UUIDs share most bits, yet land uniformly.
+	const n = 10000
+	sampled := 0
+	for range n {
+		if Permute(gen.NewV7(), key)[0] < 0x80 {
+			sampled++
+		}
+	}
+	if sampled < n*45/100 || sampled > n*55/100 {
+		t.Errorf("%d of %d permuted V7 UUIDs in the lower half, want about half", sampled, n)
+	}
+}
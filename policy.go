@@ -0,0 +1,179 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+)
+
+// Policy describes which UUIDs are acceptable at an ingress point, such as
+// "only V4 or V7, never Nil". The zero Policy accepts every UUID.
+type Policy struct {
+	Versions  []Version // allowed versions; empty allows any version
+	Variants  []Variant // allowed variants; empty allows any variant
+	RejectNil bool      // reject the Nil UUID
+	RejectMax bool      // reject the Max UUID
+}
+
+// Check returns a [*PolicyError] if u does not satisfy the policy.
+func (p Policy) Check(u UUID) error {
+	switch {
+	case p.RejectNil && u == Nil:
+		return &PolicyError{UUID: u, Msg: "Nil UUID not allowed"}
+	case p.RejectMax && u == Max:
+		return &PolicyError{UUID: u, Msg: "Max UUID not allowed"}
+	case len(p.Versions) > 0 && !slices.Contains(p.Versions, u.Version()):
+		return &PolicyError{UUID: u, Msg: fmt.Sprintf("version %v not allowed", u.Version())}
+	case len(p.Variants) > 0 && !slices.Contains(p.Variants, u.Variant()):
+		return &PolicyError{UUID: u, Msg: fmt.Sprintf("variant %v not allowed", u.Variant())}
+	}
+	return nil
+}
+
+// Parse is like the package-level [Parse] but also enforces the policy.
+func (p Policy) Parse(s string) (UUID, error) {
+	u, err := Parse(s)
+	if err != nil {
+		return Nil, err
+	}
+	if err := p.Check(u); err != nil {
+		return Nil, err
+	}
+	return u, nil
+}
+
+// Scan is like [UUID.Scan] but also enforces the policy. dst is only
+// modified if src is accepted.
+func (p Policy) Scan(dst *UUID, src any) error {
+	var u UUID
+	if err := u.Scan(src); err != nil {
+		return err
+	}
+	if err := p.Check(u); err != nil {
+		return err
+	}
+	*dst = u
+	return nil
+}
+
+// Validator returns a function that reports whether s is a 36-character
+// hyphenated UUID of one of the given versions (any version if none are
+// given). It returns a [*ParseError] or [*PolicyError], and plugs into
+// validation libraries that accept a func(string) error:
+//
+//	isV7 := uuid.Validator(uuid.V7)
+//	if err := isV7(req.ID); err != nil { ... }
+func Validator(versions ...Version) func(string) error {
+	p := Policy{Versions: slices.Clone(versions)}
+	return func(s string) error {
+		_, err := p.Parse(s)
+		return err
+	}
+}
+
+// PolicyProvider supplies the [Policy] enforced by [Checked].
+// Implementations are typically empty struct types:
+//
+//	type V4OrV7 struct{}
+//
+//	func (V4OrV7) Policy() uuid.Policy {
+//	    return uuid.Policy{Versions: []uuid.Version{uuid.V4, uuid.V7}, RejectNil: true}
+//	}
+type PolicyProvider interface {
+	Policy() Policy
+}
+
+// Checked is a UUID whose decoding methods (UnmarshalText, UnmarshalJSON,
+// and Scan) enforce the policy supplied by P. A JSON null is checked as
+// [Nil]. It encodes exactly like [UUID].
+//
+//	type Request struct {
+//	    ID uuid.Checked[V4OrV7] `json:"id"`
+//	}
+//
+// Decoders never visit fields missing from their input, so a missing "id"
+// leaves ID as Nil without an error. Call [Checked.Check] after decoding
+// when the field is mandatory.
+type Checked[P PolicyProvider] UUID
+
+// UUID returns c as a plain [UUID].
+func (c Checked[P]) UUID() UUID {
+	return UUID(c)
+}
+
+// String returns the standard 36-character hyphenated representation.
+func (c Checked[P]) String() string {
+	return UUID(c).String()
+}
+
+// MarshalText returns the 36-character hyphenated representation.
+// It implements [encoding.TextMarshaler].
+func (c Checked[P]) MarshalText() ([]byte, error) {
+	return UUID(c).MarshalText()
+}
+
+// UnmarshalText parses a UUID from text (strict 36-char format) and
+// enforces the policy. It implements [encoding.TextUnmarshaler].
+func (c *Checked[P]) UnmarshalText(data []byte) error {
+	var u UUID
+	if err := u.UnmarshalText(data); err != nil {
+		return err
+	}
+	var provider P
+	if err := provider.Policy().Check(u); err != nil {
+		return err
+	}
+	*c = Checked[P](u)
+	return nil
+}
+
+// UnmarshalJSON parses a JSON string like [UUID.UnmarshalJSON] and enforces
+// the policy. A JSON null is checked as [Nil].
+// It implements [encoding/json.Unmarshaler].
+func (c *Checked[P]) UnmarshalJSON(data []byte) error {
+	var u UUID
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	var provider P
+	if err := provider.Policy().Check(u); err != nil {
+		return err
+	}
+	*c = Checked[P](u)
+	return nil
+}
+
+// Check reports whether c satisfies the policy, returning a [*PolicyError]
+// if not. Use it to reject fields a decoder left unset.
+func (c Checked[P]) Check() error {
+	var provider P
+	return provider.Policy().Check(UUID(c))
+}
+
+// Scan implements [database/sql.Scanner] and enforces the policy.
+func (c *Checked[P]) Scan(src any) error {
+	var provider P
+	return provider.Policy().Scan((*UUID)(c), src)
+}
+
+// Value implements [database/sql/driver.Valuer].
+// It returns the UUID as a 36-character string.
+func (c Checked[P]) Value() (driver.Value, error) {
+	return UUID(c).Value()
+}
+
+// PolicyError is returned when a UUID is rejected by a [Policy].
+//
+// Use [errors.AsType] to check for this error:
+//
+//	if perr, ok := errors.AsType[*PolicyError](err); ok {
+//	    fmt.Println(perr.UUID)
+//	}
+type PolicyError struct {
+	UUID UUID   // the rejected UUID
+	Msg  string // description of the violation
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("uuid: %s rejected: %s", e.UUID, e.Msg)
+}
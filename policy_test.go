@@ -0,0 +1,210 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type v4OrV7 struct{}
+
+func (v4OrV7) Policy() Policy {
+	return Policy{Versions: []Version{V4, V7}, RejectNil: true}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		uuid   UUID
+		ok     bool
+	}{
+		{"zero policy accepts Nil", Policy{}, Nil, true},
+		{"zero policy accepts Max", Policy{}, Max, true},
+		{"reject Nil", Policy{RejectNil: true}, Nil, false},
+		{"reject Max", Policy{RejectMax: true}, Max, false},
+		{"allowed version", Policy{Versions: []Version{V4}}, MustParse("00000000-0000-4000-8000-000000000000"), true},
+		{"disallowed version", Policy{Versions: []Version{V4}}, MustParse("00000000-0000-7000-8000-000000000000"), false},
+		{"allowed variant", Policy{Variants: []Variant{VariantRFC9562}}, MustParse("00000000-0000-4000-8000-000000000000"), true},
+		{"disallowed variant", Policy{Variants: []Variant{VariantRFC9562}}, MustParse("00000000-0000-4000-c000-000000000000"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(tt.uuid)
+			if tt.ok && err != nil {
+				t.Fatalf("Check(%s) error: %v", tt.uuid, err)
+			}
+			if !tt.ok {
+				perr, ok := errors.AsType[*PolicyError](err)
+				if !ok {
+					t.Fatalf("Check(%s) error = %v, want *PolicyError", tt.uuid, err)
+				}
+				if perr.UUID != tt.uuid {
+					t.Errorf("PolicyError.UUID = %s, want %s", perr.UUID, tt.uuid)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyErrorMessage(t *testing.T) {
+	err := Policy{RejectNil: true}.Check(Nil)
+	want := "uuid: 00000000-0000-0000-0000-000000000000 rejected: Nil UUID not allowed"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestPolicyParse(t *testing.T) {
+	p := v4OrV7{}.Policy()
+	if _, err := p.Parse("00000000-0000-4000-8000-000000000001"); err != nil {
+		t.Errorf("Parse(V4) error: %v", err)
+	}
+	if _, err := p.Parse("not-a-uuid"); err == nil {
+		t.Error("Parse(invalid) should fail")
+	}
+	if _, err := p.Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); err == nil {
+		t.Error("Parse(V1) should be rejected")
+	}
+}
+
+func TestPolicyScan(t *testing.T) {
+	p := v4OrV7{}.Policy()
+	var u UUID
+	if err := p.Scan(&u, "00000000-0000-7000-8000-000000000001"); err != nil {
+		t.Fatalf("Scan(V7) error: %v", err)
+	}
+	before := u
+	if err := p.Scan(&u, 42); err == nil {
+		t.Error("Scan(int) should fail")
+	}
+	if err := p.Scan(&u, Nil.String()); err == nil {
+		t.Error("Scan(Nil) should be rejected")
+	}
+	if u != before {
+		t.Errorf("rejected Scan modified dst: %s != %s", u, before)
+	}
+}
+
+func TestValidator(t *testing.T) {
+	isV7 := Validator(V7)
+	if err := isV7("00000000-0000-7000-8000-000000000001"); err != nil {
+		t.Errorf("V7 validator rejected V7: %v", err)
+	}
+	err := isV7("00000000-0000-4000-8000-000000000001")
+	if _, ok := errors.AsType[*PolicyError](err); !ok {
+		t.Errorf("V7 validator on V4 error = %v, want *PolicyError", err)
+	}
+	if _, ok := errors.AsType[*ParseError](isV7("bogus")); !ok {
+		t.Error("V7 validator on bogus should return *ParseError")
+	}
+	if err := Validator()(Nil.String()); err != nil {
+		t.Errorf("unrestricted validator rejected Nil: %v", err)
+	}
+}
+
+func TestCheckedJSON(t *testing.T) {
+	type doc struct {
+		ID Checked[v4OrV7] `json:"id"`
+	}
+	var d doc
+	if err := json.Unmarshal([]byte(`{"id":"00000000-0000-4000-8000-000000000001"}`), &d); err != nil {
+		t.Fatalf("json.Unmarshal(V4) error: %v", err)
+	}
+	if got := d.ID.UUID(); got != MustParse("00000000-0000-4000-8000-000000000001") {
+		t.Errorf("ID = %s", got)
+	}
+	if d.ID.String() != "00000000-0000-4000-8000-000000000001" {
+		t.Errorf("String() = %q", d.ID.String())
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+	if string(b) != `{"id":"00000000-0000-4000-8000-000000000001"}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	err = json.Unmarshal([]byte(`{"id":"00000000-0000-0000-0000-000000000000"}`), &d)
+	if _, ok := errors.AsType[*PolicyError](err); !ok {
+		t.Errorf("json.Unmarshal(Nil) error = %v, want *PolicyError", err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"bogus"}`), &d); err == nil {
+		t.Error("json.Unmarshal(bogus) should fail")
+	}
+}
+
+type required struct{}
+
+func (required) Policy() Policy {
+	return Policy{RejectNil: true}
+}
+
+type unrestricted struct{}
+
+func (unrestricted) Policy() Policy {
+	return Policy{}
+}
+
+func TestCheckedJSONNull(t *testing.T) {
+	type doc struct {
+		ID Checked[required] `json:"id"`
+	}
+	d := doc{ID: Checked[required](MustParse("00000000-0000-4000-8000-000000000001"))}
+	err := json.Unmarshal([]byte(`{"id":null}`), &d)
+	if _, ok := errors.AsType[*PolicyError](err); !ok {
+		t.Errorf("json.Unmarshal(null) error = %v, want *PolicyError", err)
+	}
+	if d.ID.UUID() != MustParse("00000000-0000-4000-8000-000000000001") {
+		t.Errorf("rejected null overwrote ID with %s", d.ID)
+	}
+
+	var missing doc
+	if err := json.Unmarshal([]byte(`{}`), &missing); err != nil {
+		t.Fatalf("json.Unmarshal({}) error: %v", err)
+	}
+	if _, ok := errors.AsType[*PolicyError](missing.ID.Check()); !ok {
+		t.Errorf("Check() on missing field = %v, want *PolicyError", missing.ID.Check())
+	}
+
+	open := Checked[unrestricted](MustParse("00000000-0000-4000-8000-000000000001"))
+	if err := open.UnmarshalJSON([]byte(`null`)); err != nil || open.UUID() != Nil {
+		t.Errorf("UnmarshalJSON(null) = %s, %v, want Nil", open, err)
+	}
+	if err := open.UnmarshalJSON([]byte(`42`)); err == nil {
+		t.Error("UnmarshalJSON(42) should fail")
+	}
+	if err := open.Check(); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+}
+
+func TestCheckedText(t *testing.T) {
+	var c Checked[v4OrV7]
+	if err := c.UnmarshalText([]byte("00000000-0000-7000-8000-000000000001")); err != nil || c.UUID() != MustParse("00000000-0000-7000-8000-000000000001") {
+		t.Errorf("UnmarshalText(V7) = %s, %v", c, err)
+	}
+	if _, ok := errors.AsType[*PolicyError](c.UnmarshalText([]byte(Nil.String()))); !ok {
+		t.Error("UnmarshalText(Nil) should be rejected with *PolicyError")
+	}
+	if err := c.UnmarshalText([]byte("bogus")); err == nil {
+		t.Error("UnmarshalText(bogus) should fail")
+	}
+}
+
+func TestCheckedScanValue(t *testing.T) {
+	var c Checked[v4OrV7]
+	if err := c.Scan("00000000-0000-7000-8000-000000000001"); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+	v, err := c.Value()
+	if err != nil {
+		t.Fatalf("Value() error: %v", err)
+	}
+	if v != "00000000-0000-7000-8000-000000000001" {
+		t.Errorf("Value() = %v", v)
+	}
+	if err := c.Scan("00000000-0000-5000-8000-000000000001"); err == nil {
+		t.Error("Scan(V5) should be rejected")
+	}
+}
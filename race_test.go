@@ -0,0 +1,7 @@
+//go:build race
+
+package uuid
+
+// raceEnabled reports whether tests run under the race detector, whose
+// instrumentation makes otherwise stack-allocated buffers escape.
+const raceEnabled = true
@@ -0,0 +1,51 @@
+package uuid
+
+// RowKey returns the 16 bytes of u as a fixed-width key for ordered
+// key-value stores (Bigtable, HBase, ...). For V7 UUIDs, keys sort by
+// creation time; decode with [FromRowKey].
+func (u UUID) RowKey() []byte {
+	return u.Bytes()
+}
+
+// RowKeyDescending is like [UUID.RowKey] but with the timestamp bits of a
+// V7 UUID inverted (the 48-bit unix_ts_ms and the 12-bit rand_a holding the
+// sub-millisecond fraction), so that a forward scan returns the newest rows
+// first. The version and variant bits are kept. Decode with
+// [FromRowKeyDescending].
+func (u UUID) RowKeyDescending() []byte {
+	inv := invertTime(u)
+	return inv.Bytes()
+}
+
+// FromRowKey decodes a key produced by [UUID.RowKey]. It returns a
+// [*LengthError] if key is not 16 bytes.
+func FromRowKey(key []byte) (UUID, error) {
+	return FromBytes(key)
+}
+
+// FromRowKeyDescending decodes a key produced by [UUID.RowKeyDescending].
+// It returns a [*LengthError] if key is not 16 bytes.
+func FromRowKeyDescending(key []byte) (UUID, error) {
+	u, err := FromBytes(key)
+	if err != nil {
+		return Nil, err
+	}
+	return invertTime(u), nil
+}
+
+// ReverseV7 converts between a normal V7 UUID and one produced with
+// [WithDescendingV7] by inverting the timestamp bits. It is its own inverse.
+func ReverseV7(u UUID) UUID {
+	return invertTime(u)
+}
+
+// invertTime flips the 48-bit timestamp and the 12-bit rand_a field of u,
+// reversing the sort order of V7 UUIDs. It is its own inverse.
+func invertTime(u UUID) UUID {
+	for i := range 6 {
+		u[i] ^= 0xff
+	}
+	u[6] ^= 0x0f
+	u[7] ^= 0xff
+	return u
+}
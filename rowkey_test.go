@@ -0,0 +1,55 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestRowKey(t *testing.T) {
+	u := MustParse("018f86ba-c2bb-7abc-9123-456789abcdef")
+	if got := u.RowKey(); !bytes.Equal(got, u[:]) {
+		t.Errorf("RowKey() = %x, want %x", got, u[:])
+	}
+	want := MustParse("fe707945-3d44-7543-9123-456789abcdef")
+	if got := u.RowKeyDescending(); !bytes.Equal(got, want[:]) {
+		t.Errorf("RowKeyDescending() = %x, want %x", got, want[:])
+	}
+
+	for name, roundTrip := range map[string]func() (UUID, error){
+		"FromRowKey":           func() (UUID, error) { return FromRowKey(u.RowKey()) },
+		"FromRowKeyDescending": func() (UUID, error) { return FromRowKeyDescending(u.RowKeyDescending()) },
+	} {
+		got, err := roundTrip()
+		if err != nil || got != u {
+			t.Errorf("%s() = %s, %v, want %s", name, got, err, u)
+		}
+	}
+}
+
+func TestRowKeyErrors(t *testing.T) {
+	if _, err := FromRowKey([]byte{1, 2}); err == nil {
+		t.Error("FromRowKey(short) should fail")
+	}
+	_, err := FromRowKeyDescending([]byte{1, 2})
+	if _, ok := errors.AsType[*LengthError](err); !ok {
+		t.Errorf("FromRowKeyDescending(short) error = %v, want *LengthError", err)
+	}
+}
+
+func TestRowKeyDescendingOrder(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator()
+		var keys [][]byte
+		for range 5 {
+			keys = append(keys, gen.NewV7().RowKeyDescending())
+			time.Sleep(100 * time.Microsecond) // sub-millisecond steps exercise rand_a
+		}
+		if !slices.IsSortedFunc(keys, func(a, b []byte) int { return -bytes.Compare(a, b) }) {
+			t.Error("descending row keys are not in reverse creation order")
+		}
+	})
+}
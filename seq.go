@@ -0,0 +1,47 @@
+package uuid
+
+import "iter"
+
+// seqChunk is the number of UUIDs [V4Seq] generates per entropy read.
+const seqChunk = 64
+
+// V4Seq returns an infinite sequence of random (Version 4) UUIDs for use
+// with range-over-func:
+//
+//	for id := range uuid.V4Seq() {
+//	    if !emit(id) {
+//	        break
+//	    }
+//	}
+//
+// UUIDs are generated lazily in chunks like [FillV4], amortizing the cost of
+// crypto/rand; the rest of a chunk is discarded when the loop stops. Only
+// yielded UUIDs are reported to the hook installed with [SetGenerateHook].
+func V4Seq() iter.Seq[UUID] {
+	return func(yield func(UUID) bool) {
+		var buf [seqChunk]UUID
+		for {
+			fillV4(buf[:])
+			for _, u := range buf {
+				issued(u, V4)
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// V7Seq returns an infinite sequence of Version 7 UUIDs from g. Each UUID is
+// generated with [Generator.NewV7] when the loop asks for it, so timestamps
+// reflect when it was consumed rather than when the loop started. Use
+// [Generator.NewV7Batch] to share one clock reading across many UUIDs.
+func (g *Generator) V7Seq() iter.Seq[UUID] {
+	return func(yield func(UUID) bool) {
+		for {
+			if !yield(g.NewV7()) {
+				return
+			}
+		}
+	}
+}
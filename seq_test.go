@@ -0,0 +1,62 @@
+package uuid
+
+import (
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestV4Seq(t *testing.T) {
+	var ids []UUID
+	for u := range V4Seq() {
+		ids = append(ids, u)
+		if len(ids) == 2*seqChunk+1 {
+			break
+		}
+	}
+	seen := make(map[UUID]bool, len(ids))
+	for i, u := range ids {
+		if u.Version() != V4 || seen[u] {
+			t.Fatalf("ids[%d] = %s: not a fresh V4 UUID", i, u)
+		}
+		seen[u] = true
+	}
+}
+
+func TestV4SeqHook(t *testing.T) {
+	var rec hookRecorder
+	SetGenerateHook(rec.hook)
+	t.Cleanup(func() { SetGenerateHook(nil) })
+
+	var ids []UUID
+	for u := range V4Seq() {
+		ids = append(ids, u)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(rec.uuids, ids) {
+		t.Errorf("hook saw %d UUIDs, want the %d yielded", len(rec.uuids), len(ids))
+	}
+}
+
+func TestV7Seq(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		gen := NewGenerator()
+		var ids []UUID
+		for u := range gen.V7Seq() {
+			ids = append(ids, u)
+			if len(ids) == 3 {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+		if !slices.IsSortedFunc(ids, Compare) {
+			t.Errorf("V7Seq UUIDs not increasing: %v", ids)
+		}
+		if d := ids[2].Time().Sub(ids[0].Time()); d != 2*time.Second {
+			t.Errorf("timestamps span %v, want 2s (generated lazily)", d)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package uuid
+
+// DefaultShortLen is the length [UUID.Short] uses when n is not positive.
+const DefaultShortLen = 8
+
+// Short returns the first n hex digits of u (hyphens omitted) for
+// human-facing displays such as logs and CLI tables. n is clamped to 32; a
+// non-positive n selects [DefaultShortLen].
+//
+//	id.Short(0)  // "6ba7b810"
+//	id.Short(12) // "6ba7b8109dad"
+func (u UUID) Short(n int) string {
+	if n <= 0 {
+		n = DefaultShortLen
+	}
+	var buf [32]byte
+	encodeCompact(buf[:], u)
+	return string(buf[:min(n, 32)])
+}
+
+// MatchShort reports whether short, as produced by [UUID.Short] or typed by
+// a user, is a prefix of u's hex digits. Case and hyphens in short are
+// ignored; an empty short matches nothing.
+func MatchShort(short string, u UUID) bool {
+	p, n, ok := parsePrefix(short)
+	if !ok || n == 0 {
+		return false
+	}
+	lo, hi := prefixBounds(p, n)
+	return Compare(u, lo) >= 0 && Compare(u, hi) <= 0
+}
+
+// HasPrefixFold reports whether the 36-character hyphenated form of u
+// starts with prefix, ignoring case. Unlike [MatchShort], hyphens in prefix
+// must be at their canonical positions.
+func HasPrefixFold(u UUID, prefix string) bool {
+	if len(prefix) > 36 {
+		return false
+	}
+	var buf [36]byte
+	encodeHex(buf[:], u)
+	for i := range len(prefix) {
+		c := prefix[i]
+		if 'A' <= c && c <= 'F' {
+			c += 'a' - 'A'
+		}
+		if c != buf[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PrefixRange returns the smallest and largest UUIDs whose hex digits start
+// with prefix, so that a "search by the first characters of the ID" feature
+// can run as an index range scan (WHERE id BETWEEN lo AND hi). Case and
+// hyphens are ignored; an empty prefix yields [Nil] and [Max]. It returns a
+// [*ParseError] if prefix contains non-hex characters or more than 32
+// digits.
+func PrefixRange(prefix string) (lo, hi UUID, err error) {
+	p, n, ok := parsePrefix(prefix)
+	if !ok {
+		return Nil, Nil, &ParseError{Input: prefix, Msg: "invalid hex prefix"}
+	}
+	lo, hi = prefixBounds(p, n)
+	return lo, hi, nil
+}
+
+// parsePrefix decodes the hex digits of s, skipping hyphens, into the
+// leading nibbles of a UUID. It returns the number of digits decoded and
+// false if s contains a non-hex character or more than 32 digits.
+func parsePrefix(s string) (u UUID, n int, ok bool) {
+	for i := range len(s) {
+		c := s[i]
+		if c == '-' {
+			continue
+		}
+		v := xvalues[c]
+		if v == 0xff || n == 32 {
+			return Nil, 0, false
+		}
+		if n%2 == 0 {
+			u[n/2] = v << 4
+		} else {
+			u[n/2] |= v
+		}
+		n++
+	}
+	return u, n, true
+}
+
+// prefixBounds returns p with all but its first n nibbles cleared and set.
+func prefixBounds(p UUID, n int) (lo, hi UUID) {
+	lo, hi = p, p
+	for i := n; i < 32; i++ {
+		if i%2 == 0 {
+			hi[i/2] |= 0xf0
+		} else {
+			hi[i/2] |= 0x0f
+		}
+	}
+	return lo, hi
+}
@@ -0,0 +1,99 @@
+package uuid
+
+import "testing"
+
+func TestShort(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "6ba7b810"},
+		{-1, "6ba7b810"},
+		{4, "6ba7"},
+		{12, "6ba7b8109dad"},
+		{32, "6ba7b8109dad11d180b400c04fd430c8"},
+		{100, "6ba7b8109dad11d180b400c04fd430c8"},
+	}
+	for _, tt := range tests {
+		if got := u.Short(tt.n); got != tt.want {
+			t.Errorf("Short(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMatchShort(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		short string
+		want  bool
+	}{
+		{"6ba7b810", true},
+		{"6BA7B810", true},
+		{"6", true},
+		{"6ba7b810-9dad", true},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"6ba7b8109dad11d180b400c04fd430c8", true},
+		{"6ba7b811", false},
+		{"6ba7b81", true},
+		{"6ba7b8g", false},
+		{"", false},
+		{"---", false},
+		{"6ba7b8109dad11d180b400c04fd430c80", false},
+	}
+	for _, tt := range tests {
+		if got := MatchShort(tt.short, u); got != tt.want {
+			t.Errorf("MatchShort(%q) = %v, want %v", tt.short, got, tt.want)
+		}
+	}
+	if !MatchShort(u.Short(0), u) {
+		t.Error("MatchShort(Short(0)) = false")
+	}
+}
+
+func TestHasPrefixFold(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		prefix string
+		want   bool
+	}{
+		{"", true},
+		{"6BA7", true},
+		{"6ba7b810-9D", true},
+		{"6ba7b8109d", false}, // hyphen expected at position 8
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8x", false},
+		{"6ba8", false},
+	}
+	for _, tt := range tests {
+		if got := HasPrefixFold(u, tt.prefix); got != tt.want {
+			t.Errorf("HasPrefixFold(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixRange(t *testing.T) {
+	tests := []struct {
+		prefix string
+		lo, hi string
+	}{
+		{"", "00000000-0000-0000-0000-000000000000", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"6BA7B", "6ba7b000-0000-0000-0000-000000000000", "6ba7bfff-ffff-ffff-ffff-ffffffffffff"},
+		{"6ba7b810-9d", "6ba7b810-9d00-0000-0000-000000000000", "6ba7b810-9dff-ffff-ffff-ffffffffffff"},
+		{"6ba7b8109dad11d180b400c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	}
+	for _, tt := range tests {
+		lo, hi, err := PrefixRange(tt.prefix)
+		if err != nil {
+			t.Fatalf("PrefixRange(%q) error: %v", tt.prefix, err)
+		}
+		if lo.String() != tt.lo || hi.String() != tt.hi {
+			t.Errorf("PrefixRange(%q) = %s, %s, want %s, %s", tt.prefix, lo, hi, tt.lo, tt.hi)
+		}
+	}
+	for _, bad := range []string{"6bag", "6ba7b8109dad11d180b400c04fd430c80"} {
+		if _, _, err := PrefixRange(bad); err == nil {
+			t.Errorf("PrefixRange(%q) should fail", bad)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package uuid
+
+import "log/slog"
+
+// LogValue implements [slog.LogValuer]. Handlers resolve it to the
+// canonical string only when a record is actually emitted, so attributes
+// for disabled levels never format u.
+func (u UUID) LogValue() slog.Value {
+	return slog.StringValue(u.String())
+}
+
+// Attr returns an [slog.Attr] for u, resolved lazily through
+// [UUID.LogValue]:
+//
+//	logger.Debug("lookup", uuid.Attr("order", id))
+func Attr(key string, u UUID) slog.Attr {
+	return slog.Any(key, u)
+}
+
+// LogGroup returns a [slog.GroupValue] describing u: its id, version, and,
+// for versions that embed one, the timestamp. It is intended for debug-level
+// logging of decoded identifiers:
+//
+//	logger.Debug("lookup", slog.Any("order", id.LogGroup()))
+func (u UUID) LogGroup() slog.Value {
+	attrs := make([]slog.Attr, 0, 3)
+	attrs = append(attrs,
+		slog.String("id", u.String()),
+		slog.String("version", u.Version().String()),
+	)
+	if t, ok := u.TimeOK(); ok {
+		attrs = append(attrs, slog.Time("time", t))
+	}
+	return slog.GroupValue(attrs...)
+}
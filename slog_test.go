@@ -0,0 +1,70 @@
+package uuid
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestLogValue(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	a := Attr("id", u)
+	if a.Key != "id" || a.Value.Kind() != slog.KindLogValuer {
+		t.Fatalf("Attr() = %v (kind %v), want a LogValuer", a, a.Value.Kind())
+	}
+	if v := a.Value.Resolve(); v.Kind() != slog.KindString || v.String() != u.String() {
+		t.Errorf("Resolve() = %v (kind %v), want %s", v, v.Kind(), u)
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("m", a)
+	if got, want := buf.String(), `{"level":"INFO","msg":"m","id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`+"\n"; got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+}
+
+func TestLogGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid UUID
+		want string
+	}{
+		{
+			"V4",
+			MustParse("00000000-0000-4000-8000-000000000000"),
+			`level=INFO msg=m id.id=00000000-0000-4000-8000-000000000000 id.version=V4` + "\n",
+		},
+		{
+			"V7",
+			MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f"),
+			`level=INFO msg=m id.id=017f22e2-79b0-7cc3-98c4-dc0c0c07398f id.version=V7 id.time=` +
+				time.UnixMilli(0x017f22e279b0).Format("2006-01-02T15:04:05.000Z07:00") + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 && a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+			logger.Info("m", slog.Any("id", tt.uuid.LogGroup()))
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
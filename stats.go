@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Stats is a snapshot of the counters of a [Generator] or [Pool], for
+// periodic export to a metrics system. Counters only increase. For
+// per-event callbacks, install a [MetricsHook] with [WithMetrics] instead.
+type Stats struct {
+	Issued     uint64 // UUIDs issued, singly or in batches
+	Refills    uint64 // Pool buffer refills
+	Clamps     uint64 // UUIDs whose timestamp was advanced past the clock reading to stay monotonic
+	Rollbacks  uint64 // clock readings earlier than the previous one
+	Contention uint64 // lock acquisitions that had to wait for another goroutine
+}
+
+// counters backs [Stats]; it is shared by pointer so options stay copyable.
+type counters struct {
+	issued, refills, clamps, rollbacks, contention atomic.Uint64
+}
+
+func (c *counters) snapshot() Stats {
+	return Stats{
+		Issued:     c.issued.Load(),
+		Refills:    c.refills.Load(),
+		Clamps:     c.clamps.Load(),
+		Rollbacks:  c.rollbacks.Load(),
+		Contention: c.contention.Load(),
+	}
+}
+
+// lock acquires mu, counting the acquisition as contended if another
+// goroutine holds it.
+func (c *counters) lock(mu *sync.Mutex) {
+	if !mu.TryLock() {
+		c.contention.Add(1)
+		mu.Lock()
+	}
+}
+
+// Stats returns a snapshot of the generator's counters.
+func (g *Generator) Stats() Stats {
+	return g.opts.stats.snapshot()
+}
+
+// Stats returns a snapshot of the pool's counters.
+func (p *Pool) Stats() Stats {
+	return p.opts.stats.snapshot()
+}
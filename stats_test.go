@@ -0,0 +1,61 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestPoolStats(t *testing.T) {
+	pool := NewPool()
+	for range poolSize + 1 {
+		pool.NewV4()
+	}
+	pool.NewV7()
+
+	want := Stats{Issued: poolSize + 2, Refills: 3}
+	if got := pool.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGeneratorStats(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var offset time.Duration
+		gen := NewGenerator(WithClock(func() time.Time { return time.Now().Add(offset) }))
+
+		// Within one clock reading, every UUID after the first is advanced.
+		gen.NewV7Batch(10)
+		gen.NewV1()
+		gen.NewV6()
+		offset = -time.Second
+		gen.NewV7()
+		gen.NewV1()
+
+		want := Stats{Issued: 14, Clamps: 9 + 1 + 1 + 1, Rollbacks: 2}
+		if got := gen.Stats(); got != want {
+			t.Errorf("Stats() = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestStatsContention(t *testing.T) {
+	var c counters
+	var mu sync.Mutex
+	c.lock(&mu)
+	done := make(chan struct{})
+	go func() {
+		c.lock(&mu)
+		mu.Unlock()
+		close(done)
+	}()
+	for c.contention.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	mu.Unlock()
+	<-done
+	if got := c.snapshot().Contention; got != 1 {
+		t.Errorf("Contention = %d, want 1", got)
+	}
+}
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TemplateFuncs returns functions for use with text/template and
+// html/template, whose Funcs methods both accept the returned map:
+//
+//	tmpl := template.New("cfg").Funcs(uuid.TemplateFuncs())
+//
+// The functions are:
+//
+//	uuidNew               random (V4) UUID
+//	uuidV7                time-ordered (V7) UUID
+//	uuidV5 NS NAME        name-based (V5) UUID; NS is a UUID or string
+//	uuidParse S           UUID parsed with ParseLenient
+//	uuidURN U             urn:uuid: form
+//	uuidUpper U           upper-case hyphenated form
+//	uuidCompact U         32 hex digits without hyphens
+//
+// UUID values print in the standard hyphenated form.
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"uuidNew": NewV4,
+		"uuidV7":  NewV7,
+		"uuidV5": func(ns any, name string) (UUID, error) {
+			u, err := templateUUID(ns)
+			if err != nil {
+				return Nil, err
+			}
+			return NewV5(u, name), nil
+		},
+		"uuidParse": ParseLenient,
+		"uuidURN":   UUID.URN,
+		"uuidUpper": func(u UUID) string {
+			return strings.ToUpper(u.String())
+		},
+		"uuidCompact": func(u UUID) string {
+			return strings.ReplaceAll(u.String(), "-", "")
+		},
+	}
+}
+
+// templateUUID accepts a UUID or its textual form as a template argument.
+func templateUUID(v any) (UUID, error) {
+	switch v := v.(type) {
+	case UUID:
+		return v, nil
+	case string:
+		return ParseLenient(v)
+	default:
+		return Nil, fmt.Errorf("uuid: cannot use %T as UUID", v)
+	}
+}
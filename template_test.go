@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	tests := []struct {
+		name, tmpl, want string
+	}{
+		{"v5 string namespace", `{{uuidV5 "6ba7b810-9dad-11d1-80b4-00c04fd430c8" "www.example.com"}}`, "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"v5 UUID namespace", `{{uuidV5 (uuidParse "6ba7b810-9dad-11d1-80b4-00c04fd430c8") "www.example.com"}}`, "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"parse", `{{uuidParse "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"}}`, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"urn", `{{uuidParse "6ba7b810-9dad-11d1-80b4-00c04fd430c8" | uuidURN}}`, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"upper", `{{uuidParse "6ba7b810-9dad-11d1-80b4-00c04fd430c8" | uuidUpper}}`, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{"compact", `{{uuidParse "6ba7b810-9dad-11d1-80b4-00c04fd430c8" | uuidCompact}}`, "6ba7b8109dad11d180b400c04fd430c8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			tmpl := template.Must(template.New("t").Funcs(TemplateFuncs()).Parse(tt.tmpl))
+			if err := tmpl.Execute(&b, nil); err != nil {
+				t.Fatalf("Execute() error: %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("got %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateFuncsGenerate(t *testing.T) {
+	var b strings.Builder
+	tmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(TemplateFuncs()).Parse(`{{uuidNew}} {{uuidV7}}`))
+	if err := tmpl.Execute(&b, nil); err != nil {
+		t.Fatalf("Execute() error: %v", err)
+	}
+	ids := strings.Fields(b.String())
+	if len(ids) != 2 || MustParse(ids[0]).Version() != V4 || MustParse(ids[1]).Version() != V7 {
+		t.Errorf("got %q", b.String())
+	}
+}
+
+func TestTemplateFuncsErrors(t *testing.T) {
+	for _, src := range []string{
+		`{{uuidParse "bogus"}}`,
+		`{{uuidV5 "bogus" "name"}}`,
+		`{{uuidV5 42 "name"}}`,
+	} {
+		tmpl := template.Must(template.New("t").Funcs(TemplateFuncs()).Parse(src))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Errorf("%s: Execute() should fail", src)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package uuid
+
+// FromTraceparent extracts the 16-byte trace-id from a W3C Trace Context
+// traceparent header (version-traceid-parentid-flags, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01) and returns it
+// as a UUID whose hex form equals the trace-id, so request IDs can be
+// correlated with traces.
+//
+// The bytes are taken verbatim, so the result generally carries no valid
+// version or variant. Pass it to [NewV8] for an RFC 9562 conformant UUID,
+// at the cost of no longer matching the trace-id exactly.
+func FromTraceparent(header string) (UUID, error) {
+	// Version 00 headers are exactly 55 characters; later versions may
+	// append further dash-separated fields.
+	if len(header) < 55 || (len(header) > 55 && (header[:2] == "00" || header[55] != '-')) {
+		return Nil, &ParseError{Input: header, Msg: "malformed traceparent"}
+	}
+	if header[2] != '-' || header[35] != '-' || header[52] != '-' {
+		return Nil, &ParseError{Input: header, Msg: "malformed traceparent"}
+	}
+	if header[:2] == "ff" {
+		return Nil, &ParseError{Input: header, Msg: "invalid traceparent version"}
+	}
+	for i := range 55 {
+		c := header[i]
+		if i == 2 || i == 35 || i == 52 {
+			continue
+		}
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return Nil, &ParseError{Input: header, Msg: "traceparent must be lowercase hex"}
+		}
+	}
+	if header[36:52] == "0000000000000000" {
+		return Nil, &ParseError{Input: header, Msg: "all-zero traceparent parent-id"}
+	}
+	u, _ := parseCompact(header[3:35])
+	if u == Nil {
+		return Nil, &ParseError{Input: header, Msg: "all-zero traceparent trace-id"}
+	}
+	return u, nil
+}
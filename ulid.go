@@ -0,0 +1,27 @@
+package uuid
+
+// ToULIDString returns u in ULID text form: 26 Crockford base32 characters
+// encoding all 128 bits, as [UUID.EncodeCrockford] does. For a V7 UUID the
+// first 10 characters are the millisecond timestamp, just as in a ULID, so
+// both systems order the value the same way.
+//
+// The conversion is a change of notation only. The resulting ULID's
+// random part still contains the UUID's version and variant bits, and a
+// UUID converted with [FromULIDString] from a ULID minted elsewhere has
+// whatever bits the ULID had in those positions, so its Version and
+// Variant are meaningless.
+func (u UUID) ToULIDString() string {
+	return u.EncodeCrockford()
+}
+
+// FromULIDString parses a 26-character ULID into the UUID with the same
+// 128 bits. It accepts lower case and returns a [*ParseError] for invalid
+// characters or values above the ULID maximum, 7ZZZZZZZZZZZZZZZZZZZZZZZZZ.
+// See [UUID.ToULIDString] for the caveats.
+func FromULIDString(s string) (UUID, error) {
+	u, ok := decodeBase32(s, nil)
+	if !ok {
+		return Nil, &ParseError{Input: s, Msg: "invalid ULID"}
+	}
+	return u, nil
+}
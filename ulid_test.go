@@ -0,0 +1,35 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestULID(t *testing.T) {
+	// Example ULID from the ULID specification.
+	const ulid = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	want := MustParse("01563e3a-b5d3-d676-4c61-efb99302bd5b")
+	got, err := FromULIDString(ulid)
+	if err != nil || got != want {
+		t.Fatalf("FromULIDString(%q) = %s, %v, want %s", ulid, got, err, want)
+	}
+	if s := got.ToULIDString(); s != ulid {
+		t.Errorf("ToULIDString() = %q, want %q", s, ulid)
+	}
+	if _, err := FromULIDString("8ZZZZZZZZZZZZZZZZZZZZZZZZZ"); err == nil {
+		t.Error("FromULIDString(overflow) should fail")
+	}
+}
+
+func TestULIDTimestamp(t *testing.T) {
+	ts := time.UnixMilli(1469922850259)
+	u := V7Min(ts)
+	s := u.ToULIDString()
+	if s[:10] != "01ARZ3NDEK" {
+		t.Errorf("ToULIDString() timestamp = %q, want %q", s[:10], "01ARZ3NDEK")
+	}
+	back, err := FromULIDString(s)
+	if err != nil || back != u {
+		t.Errorf("FromULIDString(%q) = %s, %v, want %s", s, back, err, u)
+	}
+}
@@ -1,8 +1,11 @@
 // Package uuid implements UUID generation and parsing per RFC 9562.
 //
 // Supported versions:
+//   - V1 (Gregorian time + node): legacy interoperability
+//   - V2 (DCE Security): V1 with an embedded POSIX UID or GID
 //   - V4 (Random): most common
 //   - V5 (SHA-1 name-based): deterministic, canonical IDs
+//   - V6 (reordered Gregorian time + node): sortable V1 layout
 //   - V7 (Unix timestamp + random): recommended for new systems
 //   - V8 (Custom/experimental): user-provided data with version+variant bits
 //
@@ -13,8 +16,9 @@
 //
 // Stateless functions require no configuration:
 //
-//	id := uuid.NewV4()                              // random
-//	id := uuid.NewV5(uuid.NamespaceDNS, "example")  // deterministic (SHA-1)
+//	id := uuid.NewV4()                                 // random
+//	id := uuid.NewV5(uuid.NamespaceDNS, "example")     // deterministic (SHA-1)
+//	id := uuid.NewV8Name(uuid.NamespaceDNS, "example") // deterministic (SHA-256)
 //
 // For V7 UUIDs with per-instance monotonicity, use a [Generator]:
 //
@@ -42,6 +46,9 @@ package uuid
 
 import (
 	"cmp"
+	"crypto/subtle"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -74,8 +81,11 @@ type Version uint8
 // UUID version constants.
 const (
 	VNil Version = 0
+	V1   Version = 1
+	V2   Version = 2
 	V4   Version = 4
 	V5   Version = 5
+	V6   Version = 6
 	V7   Version = 7
 	V8   Version = 8
 	VMax Version = 15
@@ -86,10 +96,16 @@ func (v Version) String() string {
 	switch v {
 	case VNil:
 		return "NIL"
+	case V1:
+		return "V1"
+	case V2:
+		return "V2"
 	case V4:
 		return "V4"
 	case V5:
 		return "V5"
+	case V6:
+		return "V6"
 	case V7:
 		return "V7"
 	case V8:
@@ -101,6 +117,25 @@ func (v Version) String() string {
 	}
 }
 
+// ParseVersionName parses a version as written in configuration files and
+// command-line flags: a number from 0 to 15 with an optional "v" prefix
+// ("4", "v4", "V7"), or "nil" or "max", all case-insensitive. It returns a
+// [*ParseError] for any other input.
+func ParseVersionName(s string) (Version, error) {
+	name := strings.ToLower(s)
+	switch name {
+	case "nil":
+		return VNil, nil
+	case "max":
+		return VMax, nil
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(name, "v"), 10, 8)
+	if err != nil || n > 15 {
+		return 0, &ParseError{Input: s, Msg: "unknown version name"}
+	}
+	return Version(n), nil
+}
+
 // Variant represents the UUID variant field.
 type Variant uint8
 
@@ -153,6 +188,36 @@ func (u UUID) IsNil() bool {
 	return u == Nil
 }
 
+// IsMax reports whether u is the Max UUID (all 0xFF bytes).
+func (u UUID) IsMax() bool {
+	return u == Max
+}
+
+// IsSpecial reports whether u is the Nil or Max UUID, which are commonly used
+// as sentinels (e.g. open-ended range bounds) rather than as identifiers.
+func (u UUID) IsSpecial() bool {
+	return u == Nil || u == Max
+}
+
+// PtrTo returns a pointer to a copy of u, or nil if u is the Nil UUID. It
+// converts a domain value into an optional *UUID field, which encodes as
+// JSON null and SQL NULL when nil.
+func PtrTo(u UUID) *UUID {
+	if u == Nil {
+		return nil
+	}
+	return &u
+}
+
+// ValueOr returns *p, or def if p is nil. It converts an optional *UUID
+// field back into a domain value.
+func ValueOr(p *UUID, def UUID) UUID {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
 // Bytes returns a copy of the UUID as a 16-byte slice.
 func (u UUID) Bytes() []byte {
 	b := make([]byte, 16)
@@ -160,17 +225,79 @@ func (u UUID) Bytes() []byte {
 	return b
 }
 
+// Zeroize overwrites u with the Nil UUID, for identifiers that must not
+// linger in memory after use.
+func (u *UUID) Zeroize() {
+	clear(u[:])
+}
+
+// ZeroizeAll overwrites every UUID in ids with the Nil UUID.
+func ZeroizeAll(ids []UUID) {
+	clear(ids)
+}
+
 // Time extracts the millisecond-precision Unix timestamp from a V7 UUID.
-// For non-V7 UUIDs, the returned time is meaningless.
+// For non-V7 UUIDs, the returned time is meaningless; [UUID.TimeOK] decodes
+// V1, V6, and V7 timestamps and reports false for other versions.
+// [UUID.TimePrecise] also decodes the sub-millisecond fraction.
 func (u UUID) Time() time.Time {
 	ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 |
 		int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
 	return time.UnixMilli(ms)
 }
 
+// TimeOK is like [UUID.Time] but reports whether u's version embeds a
+// timestamp. For V1 and V6 UUIDs it decodes the 60-bit Gregorian timestamp
+// at its full 100 ns resolution. For other versions it returns the zero time
+// and false, instead of decoding random bits as a date.
+func (u UUID) TimeOK() (time.Time, bool) {
+	switch u.Version() {
+	case V7:
+		return u.Time(), true
+	case V1, V6:
+		return gregorianTime(gregorianTicks(u)), true
+	}
+	return time.Time{}, false
+}
+
+// TimePrecise is like [UUID.TimeOK] but also decodes the 12-bit rand_a
+// field of a V7 UUID as a sub-millisecond fraction, as written by RFC 9562
+// Method 3 (the default for [Generator] and [Pool]), giving a resolution of
+// about 244 ns. The result is rounded down to that resolution. For V7 UUIDs
+// created by other generators, or with [WithV7Precision], rand_a is random
+// and the fraction is meaningless. V1 and V6 timestamps are returned as by
+// TimeOK.
+func (u UUID) TimePrecise() (time.Time, bool) {
+	t, ok := u.TimeOK()
+	if !ok || u.Version() != V7 {
+		return t, ok
+	}
+	frac := int64(u[6]&0x0f)<<8 | int64(u[7])
+	return t.Add(time.Duration(frac * nanoPerMilli / 4096)), true
+}
+
 // Compare returns an integer comparing two UUIDs lexicographically.
 // The result is 0 if a == b, -1 if a < b, and +1 if a > b.
 // This is suitable for use with [slices.SortFunc].
 func Compare(a, b UUID) int {
 	return cmp.Compare(string(a[:]), string(b[:]))
 }
+
+// EqualString reports whether s is the 36-character hyphenated form of u,
+// ignoring the case of hex digits. It does not allocate, and its running time
+// depends only on the length of s, not on the contents of u or s, which makes
+// it suitable for validating bearer-style identifiers.
+func EqualString(u UUID, s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	var lower [36]byte
+	encodeHex(lower[:], u)
+	eq := 1
+	for i := range 36 {
+		// Hex letters have bit 0x40 set; clearing 0x20 on them yields upper case.
+		upper := lower[i] &^ ((lower[i] & 0x40) >> 1)
+		eq &= subtle.ConstantTimeByteEq(s[i], lower[i]) | subtle.ConstantTimeByteEq(s[i], upper)
+	}
+	return eq == 1
+}
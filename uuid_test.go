@@ -2,6 +2,7 @@ package uuid
 
 import (
 	"testing"
+	"testing/synctest"
 	"time"
 )
 
@@ -61,6 +62,35 @@ func TestVersion(t *testing.T) {
 	}
 }
 
+func TestParseVersionName(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Version
+	}{
+		{"4", V4},
+		{"v4", V4},
+		{"V7", V7},
+		{"v08", V8},
+		{"0", VNil},
+		{"nil", VNil},
+		{"NIL", VNil},
+		{"max", VMax},
+		{"15", VMax},
+		{"v6", Version(6)},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersionName(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseVersionName(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "v", "16", "-1", "+4", "v+4", "four", "v4.0"} {
+		if _, err := ParseVersionName(bad); err == nil {
+			t.Errorf("ParseVersionName(%q) should fail", bad)
+		}
+	}
+}
+
 func TestVariant(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -126,6 +156,68 @@ func TestIsNil(t *testing.T) {
 	}
 }
 
+func TestIsMaxIsSpecial(t *testing.T) {
+	tests := []struct {
+		uuid             UUID
+		isMax, isSpecial bool
+	}{
+		{Nil, false, true},
+		{Max, true, true},
+		{NamespaceDNS, false, false},
+	}
+	for _, tt := range tests {
+		if got := tt.uuid.IsMax(); got != tt.isMax {
+			t.Errorf("%s.IsMax() = %v, want %v", tt.uuid, got, tt.isMax)
+		}
+		if got := tt.uuid.IsSpecial(); got != tt.isSpecial {
+			t.Errorf("%s.IsSpecial() = %v, want %v", tt.uuid, got, tt.isSpecial)
+		}
+	}
+}
+
+func TestPtrTo(t *testing.T) {
+	if p := PtrTo(Nil); p != nil {
+		t.Errorf("PtrTo(Nil) = %v, want nil", p)
+	}
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	p := PtrTo(u)
+	if p == nil || *p != u {
+		t.Fatalf("PtrTo(%s) = %v", u, p)
+	}
+	*p = Max
+	if u == Max {
+		t.Error("PtrTo did not copy its argument")
+	}
+}
+
+func TestValueOr(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	if got := ValueOr(nil, Max); got != Max {
+		t.Errorf("ValueOr(nil, Max) = %s, want Max", got)
+	}
+	if got := ValueOr(&u, Max); got != u {
+		t.Errorf("ValueOr(&u, Max) = %s, want %s", got, u)
+	}
+}
+
+func TestZeroize(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	u.Zeroize()
+	if !u.IsNil() {
+		t.Errorf("Zeroize() left %s, want Nil", u)
+	}
+}
+
+func TestZeroizeAll(t *testing.T) {
+	ids := []UUID{Max, MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")}
+	ZeroizeAll(ids)
+	for i, u := range ids {
+		if !u.IsNil() {
+			t.Errorf("ids[%d] = %s after ZeroizeAll, want Nil", i, u)
+		}
+	}
+}
+
 func TestCompare(t *testing.T) {
 	a := MustParse("00000000-0000-0000-0000-000000000001")
 	b := MustParse("00000000-0000-0000-0000-000000000002")
@@ -141,6 +233,32 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+func TestEqualString(t *testing.T) {
+	u := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", true},
+		{"6Ba7b810-9dAd-11d1-80b4-00c04fD430c8", true},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c9", false},
+		{"6ba7b810+9dad-11d1-80b4-00c04fd430c8", false},
+		{"6ba7b8109dad11d180b400c04fd430c8", false},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", false},
+		{"", false},
+		// Case folding must only apply to hex letters.
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cH", false},
+		{"6ba7b810\r9dad-11d1-80b4-00c04fd430c8", false},
+		{"\x16ba7b810-9dad-11d1-80b4-00c04fd430c8", false},
+	}
+	for _, tt := range tests {
+		if got := EqualString(u, tt.s); got != tt.want {
+			t.Errorf("EqualString(%s, %q) = %v, want %v", u, tt.s, got, tt.want)
+		}
+	}
+}
+
 func TestTimeV7(t *testing.T) {
 	// Build a V7 UUID with a known timestamp
 	now := time.Now().Truncate(time.Millisecond)
@@ -162,6 +280,35 @@ func TestTimeV7(t *testing.T) {
 	}
 }
 
+func TestTimeOK(t *testing.T) {
+	v7 := MustParse("018f86ba-c2bb-7000-8000-000000000001")
+	got, ok := v7.TimeOK()
+	if !ok || got != v7.Time() {
+		t.Errorf("TimeOK(V7) = %v, %v, want %v, true", got, ok, v7.Time())
+	}
+	got, ok = MustParse("ffffffff-ffff-4fff-bfff-ffffffffffff").TimeOK()
+	if ok || !got.IsZero() {
+		t.Errorf("TimeOK(V4) = %v, %v, want zero time, false", got, ok)
+	}
+}
+
+func TestTimePrecise(t *testing.T) {
+	if _, ok := MustParse("ffffffff-ffff-4fff-bfff-ffffffffffff").TimePrecise(); ok {
+		t.Error("TimePrecise(V4) ok = true, want false")
+	}
+	synctest.Test(t, func(t *testing.T) {
+		want := time.Now().Add(123_456_789 * time.Nanosecond)
+		time.Sleep(time.Until(want))
+		got, ok := NewGenerator().NewV7().TimePrecise()
+		if !ok {
+			t.Fatal("TimePrecise(V7) ok = false")
+		}
+		if d := want.Sub(got); d < 0 || d >= 245*time.Nanosecond {
+			t.Errorf("TimePrecise() = %v, want within 244ns below %v", got, want)
+		}
+	})
+}
+
 func TestUUIDComparable(t *testing.T) {
 	// Verify UUID can be used as a map key
 	a := MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
@@ -183,7 +330,10 @@ func TestVersionString(t *testing.T) {
 		{V7, "V7"},
 		{V8, "V8"},
 		{VMax, "MAX"},
-		{Version(2), "unknown"},
+		{V1, "V1"},
+		{V2, "V2"},
+		{V6, "V6"},
+		{Version(3), "unknown"},
 	}
 	for _, tt := range tests {
 		if got := tt.v.String(); got != tt.want {
@@ -0,0 +1,148 @@
+// Package uuidbson stores [uuid.UUID] in MongoDB as BSON binary subtype 4,
+// the standard UUID representation, instead of the 36-character string
+// that the bson package produces from MarshalText. Either register the
+// codec, so plain uuid.UUID fields encode as binary:
+//
+//	reg := bson.NewRegistry()
+//	uuidbson.Register(reg)
+//	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetRegistry(reg))
+//
+// or use the [UUID] wrapper, which implements [bson.ValueMarshaler] and
+// [bson.ValueUnmarshaler] and needs no registration.
+//
+// Decoding also accepts legacy subtype 3 binaries, read in standard byte
+// order as written by the Go and Python drivers, and string values, so
+// collections that stored UUIDs as text keep working during a migration.
+package uuidbson
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pscheid92/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+var tUUID = reflect.TypeFor[uuid.UUID]()
+
+// Register installs an encoder and decoder for uuid.UUID in reg. After
+// registration uuid.UUID and *uuid.UUID (NULL as nil) fields encode as
+// binary subtype 4.
+func Register(reg *bsoncodec.Registry) {
+	reg.RegisterTypeEncoder(tUUID, bsoncodec.ValueEncoderFunc(encodeValue))
+	reg.RegisterTypeDecoder(tUUID, bsoncodec.ValueDecoderFunc(decodeValue))
+}
+
+func encodeValue(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	u := val.Interface().(uuid.UUID)
+	return vw.WriteBinaryWithSubtype(u[:], bson.TypeBinaryUUID)
+}
+
+func decodeValue(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	var u uuid.UUID
+	switch vr.Type() {
+	case bson.TypeBinary:
+		data, subtype, err := vr.ReadBinary()
+		if err != nil {
+			return err
+		}
+		if u, err = fromBinary(subtype, data); err != nil {
+			return err
+		}
+	case bson.TypeString:
+		s, err := vr.ReadString()
+		if err != nil {
+			return err
+		}
+		if u, err = uuid.ParseLenient(s); err != nil {
+			return err
+		}
+	case bson.TypeNull:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	default:
+		return typeError(vr.Type())
+	}
+	val.Set(reflect.ValueOf(u))
+	return nil
+}
+
+// UUID is a [uuid.UUID] that encodes as BSON binary subtype 4 without a
+// registered codec:
+//
+//	type User struct {
+//	    ID uuidbson.UUID `bson:"_id"`
+//	}
+type UUID uuid.UUID
+
+// UUID returns u as a plain [uuid.UUID].
+func (u UUID) UUID() uuid.UUID {
+	return uuid.UUID(u)
+}
+
+// String returns the standard 36-character hyphenated representation.
+func (u UUID) String() string {
+	return uuid.UUID(u).String()
+}
+
+// MarshalBSONValue implements [bson.ValueMarshaler]. It returns u as
+// binary subtype 4.
+func (u UUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUID, u[:]), nil
+}
+
+// UnmarshalBSONValue implements [bson.ValueUnmarshaler]. It accepts binary
+// subtypes 4 and 3, strings in any form [uuid.ParseLenient] does, and null,
+// which sets u to [uuid.Nil].
+func (u *UUID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	switch t {
+	case bson.TypeBinary:
+		subtype, bin, _, ok := bsoncore.ReadBinary(data)
+		if !ok {
+			return malformedError(t)
+		}
+		parsed, err := fromBinary(subtype, bin)
+		if err != nil {
+			return err
+		}
+		*u = UUID(parsed)
+	case bson.TypeString:
+		s, _, ok := bsoncore.ReadString(data)
+		if !ok {
+			return malformedError(t)
+		}
+		parsed, err := uuid.ParseLenient(s)
+		if err != nil {
+			return err
+		}
+		*u = UUID(parsed)
+	case bson.TypeNull:
+		*u = UUID{}
+	default:
+		return typeError(t)
+	}
+	return nil
+}
+
+// fromBinary decodes a binary value of subtype 4 or legacy subtype 3.
+func fromBinary(subtype byte, data []byte) (uuid.UUID, error) {
+	if subtype != bson.TypeBinaryUUID && subtype != bson.TypeBinaryUUIDOld {
+		return uuid.Nil, fmt.Errorf("uuidbson: cannot decode binary subtype %#x into UUID", subtype)
+	}
+	var u uuid.UUID
+	err := u.UnmarshalBinary(data)
+	return u, err
+}
+
+func typeError(t bsontype.Type) error {
+	return fmt.Errorf("uuidbson: cannot decode BSON %v into UUID", t)
+}
+
+func malformedError(t bsontype.Type) error {
+	return fmt.Errorf("uuidbson: malformed BSON %v value", t)
+}
@@ -0,0 +1,191 @@
+package uuidbson
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pscheid92/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+var testUUID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+type row struct {
+	ID  uuid.UUID  `bson:"id"`
+	Ref *uuid.UUID `bson:"ref"`
+}
+
+func marshal(t *testing.T, v any) []byte {
+	t.Helper()
+	reg := bson.NewRegistry()
+	Register(reg)
+	var buf bytes.Buffer
+	vw, err := bsonrw.NewBSONValueWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := bson.NewEncoder(vw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetRegistry(reg)
+	if err := enc.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func unmarshal(data []byte, v any) error {
+	reg := bson.NewRegistry()
+	Register(reg)
+	dec, err := bson.NewDecoder(bsonrw.NewBSONDocumentReader(data))
+	if err != nil {
+		return err
+	}
+	dec.SetRegistry(reg)
+	return dec.Decode(v)
+}
+
+// binaryDoc returns a document with a single binary field "id".
+func binaryDoc(subtype byte, b []byte) []byte {
+	return bsoncore.NewDocumentBuilder().AppendBinary("id", subtype, b).Build()
+}
+
+func TestRegister(t *testing.T) {
+	data := marshal(t, row{ID: testUUID})
+	subtype, bin, ok := bson.Raw(data).Lookup("id").BinaryOK()
+	if !ok || subtype != bson.TypeBinaryUUID || !bytes.Equal(bin, testUUID[:]) {
+		t.Errorf("id encoded as %v, want binary subtype 4", bson.Raw(data).Lookup("id"))
+	}
+	if got := bson.Raw(data).Lookup("ref").Type; got != bson.TypeNull {
+		t.Errorf("nil ref encoded as %v, want null", got)
+	}
+
+	var r row
+	if err := unmarshal(data, &r); err != nil || r.ID != testUUID || r.Ref != nil {
+		t.Errorf("decode = %+v, %v", r, err)
+	}
+}
+
+func TestRegisterDecode(t *testing.T) {
+	docs := [][]byte{
+		binaryDoc(bson.TypeBinaryUUID, testUUID[:]),
+		binaryDoc(bson.TypeBinaryUUIDOld, testUUID[:]),
+		bsoncore.NewDocumentBuilder().AppendString("id", testUUID.String()).Build(),
+	}
+	for _, doc := range docs {
+		var r row
+		if err := unmarshal(doc, &r); err != nil || r.ID != testUUID {
+			t.Errorf("decode %v = %s, %v, want %s", bson.Raw(doc), r.ID, err, testUUID)
+		}
+	}
+
+	r := row{ID: testUUID}
+	if err := unmarshal(bsoncore.NewDocumentBuilder().AppendNull("id").Build(), &r); err != nil || r.ID != uuid.Nil {
+		t.Errorf("decode null = %s, %v, want Nil", r.ID, err)
+	}
+
+	bad := [][]byte{
+		binaryDoc(bson.TypeBinaryGeneric, testUUID[:]),
+		binaryDoc(bson.TypeBinaryUUID, testUUID[:15]),
+		bsoncore.NewDocumentBuilder().AppendString("id", "not-a-uuid").Build(),
+		bsoncore.NewDocumentBuilder().AppendInt32("id", 1).Build(),
+	}
+	for _, doc := range bad {
+		var r row
+		if err := unmarshal(doc, &r); err == nil {
+			t.Errorf("decode %v succeeded, want error", bson.Raw(doc))
+		}
+	}
+}
+
+func TestUUIDMarshal(t *testing.T) {
+	type doc struct {
+		ID UUID `bson:"id"`
+	}
+	data, err := bson.Marshal(doc{ID: UUID(testUUID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subtype, bin, ok := bson.Raw(data).Lookup("id").BinaryOK()
+	if !ok || subtype != bson.TypeBinaryUUID || !bytes.Equal(bin, testUUID[:]) {
+		t.Errorf("id encoded as %v, want binary subtype 4", bson.Raw(data).Lookup("id"))
+	}
+
+	var d doc
+	if err := bson.Unmarshal(data, &d); err != nil || d.ID.UUID() != testUUID || d.ID.String() != testUUID.String() {
+		t.Errorf("Unmarshal() = %s, %v, want %s", d.ID, err, testUUID)
+	}
+}
+
+func TestUUIDUnmarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		t    bsontype.Type
+		data []byte
+		want uuid.UUID
+	}{
+		{bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUID, testUUID[:]), testUUID},
+		{bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUIDOld, testUUID[:]), testUUID},
+		{bson.TypeString, bsoncore.AppendString(nil, testUUID.URN()), testUUID},
+		{bson.TypeNull, nil, uuid.Nil},
+	}
+	for _, tt := range tests {
+		u := UUID(uuid.Max)
+		if err := u.UnmarshalBSONValue(tt.t, tt.data); err != nil || u.UUID() != tt.want {
+			t.Errorf("UnmarshalBSONValue(%v, %x) = %s, %v, want %s", tt.t, tt.data, u, err, tt.want)
+		}
+	}
+
+	bad := []struct {
+		t    bsontype.Type
+		data []byte
+	}{
+		{bson.TypeBinary, []byte{1}},
+		{bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryGeneric, testUUID[:])},
+		{bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUID, testUUID[:8])},
+		{bson.TypeString, []byte{1}},
+		{bson.TypeString, bsoncore.AppendString(nil, "not-a-uuid")},
+		{bson.TypeInt32, bsoncore.AppendInt32(nil, 1)},
+	}
+	for _, tt := range bad {
+		var u UUID
+		if err := u.UnmarshalBSONValue(tt.t, tt.data); err == nil {
+			t.Errorf("UnmarshalBSONValue(%v, %x) succeeded, want error", tt.t, tt.data)
+		}
+	}
+
+	var u UUID
+	err := u.UnmarshalBSONValue(bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUID, testUUID[:8]))
+	if _, ok := errors.AsType[*uuid.LengthError](err); !ok {
+		t.Errorf("short binary error = %v, want *uuid.LengthError", err)
+	}
+}
+
+// failingReader reports type t and fails every read.
+type failingReader struct {
+	bsonrw.ValueReader
+	t bsontype.Type
+}
+
+func (r failingReader) Type() bsontype.Type               { return r.t }
+func (r failingReader) ReadBinary() ([]byte, byte, error) { return nil, 0, errRead }
+func (r failingReader) ReadString() (string, error)       { return "", errRead }
+func (r failingReader) ReadNull() error                   { return errRead }
+
+var errRead = errors.New("read failed")
+
+func TestDecodeValueReadError(t *testing.T) {
+	for _, bt := range []bsontype.Type{bson.TypeBinary, bson.TypeString, bson.TypeNull} {
+		var u uuid.UUID
+		err := decodeValue(bsoncodec.DecodeContext{}, failingReader{t: bt}, reflect.ValueOf(&u).Elem())
+		if !errors.Is(err, errRead) {
+			t.Errorf("decodeValue(%v) error = %v, want %v", bt, err, errRead)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/pscheid92/uuid/uuidbson
+
+go 1.26.0
+
+require (
+	github.com/pscheid92/uuid v0.2.0
+	go.mongodb.org/mongo-driver v1.17.6
+)
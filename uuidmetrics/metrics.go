@@ -0,0 +1,156 @@
+// Package uuidmetrics exports UUID generation metrics via expvar and
+// Prometheus.
+//
+// A [Metrics] value implements [uuid.MetricsHook]; install it on any number
+// of generators and pools, then expose it:
+//
+//	m := uuidmetrics.New()
+//	gen := uuid.NewGenerator(uuid.WithMetrics(m))
+//	pool := uuid.NewPool(uuid.WithMetrics(m))
+//
+//	prometheus.MustRegister(m) // Prometheus
+//	m.Publish("uuid")          // expvar
+package uuidmetrics
+
+import (
+	"expvar"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/pscheid92/uuid"
+)
+
+// batchBuckets are the upper bounds of the batch size histogram.
+var batchBuckets = [...]float64{1, 10, 100, 1_000, 10_000, 100_000}
+
+// Metrics counts generation events with lock-free counters. It implements
+// [uuid.MetricsHook] and [prometheus.Collector]. The zero value is not
+// usable; create one with [New].
+type Metrics struct {
+	generated [16]atomic.Uint64 // UUIDs issued, indexed by version
+	refills   [16]atomic.Uint64 // pool refills, indexed by version
+
+	rollbacks     atomic.Uint64
+	rollbackNanos atomic.Uint64
+
+	batches      atomic.Uint64
+	batchUUIDs   atomic.Uint64
+	batchBuckets [len(batchBuckets)]atomic.Uint64 // non-cumulative counts per bucket
+
+	generatedDesc *prometheus.Desc
+	refillsDesc   *prometheus.Desc
+	rollbacksDesc *prometheus.Desc
+	rollbackDesc  *prometheus.Desc
+	batchDesc     *prometheus.Desc
+}
+
+// New returns a new, empty [Metrics].
+func New() *Metrics {
+	return &Metrics{
+		generatedDesc: prometheus.NewDesc("uuid_generated_total",
+			"UUIDs issued by generators and pools.", []string{"version"}, nil),
+		refillsDesc: prometheus.NewDesc("uuid_pool_refills_total",
+			"Pool buffer refills.", []string{"version"}, nil),
+		rollbacksDesc: prometheus.NewDesc("uuid_clock_rollbacks_total",
+			"Times the wall clock was observed moving backwards.", nil, nil),
+		rollbackDesc: prometheus.NewDesc("uuid_clock_rollback_seconds_total",
+			"Total distance the wall clock moved backwards.", nil, nil),
+		batchDesc: prometheus.NewDesc("uuid_batch_size",
+			"Sizes of batch generation calls.", nil, nil),
+	}
+}
+
+// Generated implements [uuid.MetricsHook].
+func (m *Metrics) Generated(v uuid.Version) {
+	m.generated[v&0x0f].Add(1)
+}
+
+// Batch implements [uuid.MetricsHook].
+func (m *Metrics) Batch(v uuid.Version, n int) {
+	m.generated[v&0x0f].Add(uint64(n))
+	m.batches.Add(1)
+	m.batchUUIDs.Add(uint64(n))
+	for i, le := range batchBuckets {
+		if float64(n) <= le {
+			m.batchBuckets[i].Add(1)
+			return
+		}
+	}
+}
+
+// Refilled implements [uuid.MetricsHook].
+func (m *Metrics) Refilled(v uuid.Version) {
+	m.refills[v&0x0f].Add(1)
+}
+
+// ClockRollback implements [uuid.MetricsHook].
+func (m *Metrics) ClockRollback(d time.Duration) {
+	m.rollbacks.Add(1)
+	m.rollbackNanos.Add(uint64(d))
+}
+
+// Describe implements [prometheus.Collector].
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.generatedDesc
+	ch <- m.refillsDesc
+	ch <- m.rollbacksDesc
+	ch <- m.rollbackDesc
+	ch <- m.batchDesc
+}
+
+// Collect implements [prometheus.Collector].
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	for v := range m.generated {
+		if n := m.generated[v].Load(); n > 0 {
+			ch <- prometheus.MustNewConstMetric(m.generatedDesc, prometheus.CounterValue,
+				float64(n), uuid.Version(v).String())
+		}
+		if n := m.refills[v].Load(); n > 0 {
+			ch <- prometheus.MustNewConstMetric(m.refillsDesc, prometheus.CounterValue,
+				float64(n), uuid.Version(v).String())
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(m.rollbacksDesc, prometheus.CounterValue,
+		float64(m.rollbacks.Load()))
+	ch <- prometheus.MustNewConstMetric(m.rollbackDesc, prometheus.CounterValue,
+		time.Duration(m.rollbackNanos.Load()).Seconds())
+
+	buckets := make(map[float64]uint64, len(batchBuckets))
+	var cumulative uint64
+	for i, le := range batchBuckets {
+		cumulative += m.batchBuckets[i].Load()
+		buckets[le] = cumulative
+	}
+	ch <- prometheus.MustNewConstHistogram(m.batchDesc,
+		m.batches.Load(), float64(m.batchUUIDs.Load()), buckets)
+}
+
+// Snapshot returns the current counter values keyed by name, in the shape
+// published to expvar.
+func (m *Metrics) Snapshot() map[string]any {
+	generated := make(map[string]uint64)
+	refills := make(map[string]uint64)
+	for v := range m.generated {
+		if n := m.generated[v].Load(); n > 0 {
+			generated[uuid.Version(v).String()] = n
+		}
+		if n := m.refills[v].Load(); n > 0 {
+			refills[uuid.Version(v).String()] = n
+		}
+	}
+	return map[string]any{
+		"generated":              generated,
+		"pool_refills":           refills,
+		"clock_rollbacks":        m.rollbacks.Load(),
+		"clock_rollback_seconds": time.Duration(m.rollbackNanos.Load()).Seconds(),
+		"batches":                m.batches.Load(),
+		"batch_uuids":            m.batchUUIDs.Load(),
+	}
+}
+
+// Publish exposes the metrics as an expvar variable with the given name.
+// Like [expvar.Publish], it panics if the name is already registered.
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any { return m.Snapshot() }))
+}
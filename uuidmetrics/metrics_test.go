@@ -0,0 +1,92 @@
+package uuidmetrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/pscheid92/uuid"
+)
+
+func TestMetricsWithGeneratorAndPool(t *testing.T) {
+	m := New()
+	gen := uuid.NewGenerator(uuid.WithMetrics(m))
+	pool := uuid.NewPool(uuid.WithMetrics(m))
+
+	gen.NewV7()
+	gen.NewV7Batch(50)
+	pool.NewV4()
+	pool.NewV7()
+
+	snap := m.Snapshot()
+	generated := snap["generated"].(map[string]uint64)
+	if generated["V7"] != 52 || generated["V4"] != 1 {
+		t.Errorf("generated = %v, want V7:52 V4:1", generated)
+	}
+	refills := snap["pool_refills"].(map[string]uint64)
+	if refills["V7"] != 1 || refills["V4"] != 1 {
+		t.Errorf("pool_refills = %v, want V7:1 V4:1", refills)
+	}
+	if snap["batches"] != uint64(1) || snap["batch_uuids"] != uint64(50) {
+		t.Errorf("batches = %v, batch_uuids = %v, want 1 and 50", snap["batches"], snap["batch_uuids"])
+	}
+}
+
+func TestMetricsPrometheus(t *testing.T) {
+	m := New()
+	m.Generated(uuid.V4)
+	m.Batch(uuid.V7, 5)
+	m.Batch(uuid.V7, 1_000_000)
+	m.Refilled(uuid.V4)
+	m.ClockRollback(1500 * time.Millisecond)
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(m)
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error: %v", err)
+	}
+	got := make(map[string]*dto.MetricFamily, len(families))
+	for _, f := range families {
+		got[f.GetName()] = f
+	}
+
+	if n := len(got["uuid_generated_total"].GetMetric()); n != 2 {
+		t.Errorf("uuid_generated_total has %d series, want 2", n)
+	}
+	if v := got["uuid_pool_refills_total"].GetMetric()[0].GetCounter().GetValue(); v != 1 {
+		t.Errorf("uuid_pool_refills_total = %v, want 1", v)
+	}
+	if v := got["uuid_clock_rollbacks_total"].GetMetric()[0].GetCounter().GetValue(); v != 1 {
+		t.Errorf("uuid_clock_rollbacks_total = %v, want 1", v)
+	}
+	if v := got["uuid_clock_rollback_seconds_total"].GetMetric()[0].GetCounter().GetValue(); v != 1.5 {
+		t.Errorf("uuid_clock_rollback_seconds_total = %v, want 1.5", v)
+	}
+	h := got["uuid_batch_size"].GetMetric()[0].GetHistogram()
+	if h.GetSampleCount() != 2 || h.GetSampleSum() != 1_000_005 {
+		t.Errorf("uuid_batch_size count = %d, sum = %v, want 2 and 1000005", h.GetSampleCount(), h.GetSampleSum())
+	}
+	for _, b := range h.GetBucket() {
+		if b.GetUpperBound() == 10 && b.GetCumulativeCount() != 1 {
+			t.Errorf("bucket le=10 = %d, want 1", b.GetCumulativeCount())
+		}
+	}
+}
+
+func TestMetricsPublish(t *testing.T) {
+	m := New()
+	m.Generated(uuid.V4)
+	m.Publish("uuid_test")
+
+	var snap map[string]any
+	if err := json.Unmarshal([]byte(expvar.Get("uuid_test").String()), &snap); err != nil {
+		t.Fatalf("expvar value is not JSON: %v", err)
+	}
+	if snap["generated"].(map[string]any)["V4"] != float64(1) {
+		t.Errorf("expvar generated = %v, want V4:1", snap["generated"])
+	}
+}
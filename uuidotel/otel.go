@@ -0,0 +1,40 @@
+// Package uuidotel standardizes how UUIDs appear in OpenTelemetry traces.
+//
+//	span.SetAttributes(uuidotel.Attr("order.id", id))
+//	uuidotel.RecordGenerated(ctx, id)
+package uuidotel
+
+import (
+	"context"
+
+	"github.com/pscheid92/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EventName is the name of the span event added by [RecordGenerated].
+const EventName = "uuid.generated"
+
+// Attribute keys used on [EventName] events.
+const (
+	KeyID      attribute.Key = "uuid.id"
+	KeyVersion attribute.Key = "uuid.version"
+)
+
+// Attr returns an attribute holding the 36-character hyphenated form of u.
+func Attr(key string, u uuid.UUID) attribute.KeyValue {
+	return attribute.String(key, u.String())
+}
+
+// RecordGenerated adds an [EventName] event carrying u and its version to
+// the span in ctx. It does nothing if ctx carries no recording span.
+func RecordGenerated(ctx context.Context, u uuid.UUID) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.AddEvent(EventName, trace.WithAttributes(
+		KeyID.String(u.String()),
+		KeyVersion.String(u.Version().String()),
+	))
+}
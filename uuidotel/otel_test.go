@@ -0,0 +1,52 @@
+package uuidotel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pscheid92/uuid"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAttr(t *testing.T) {
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	kv := Attr("order.id", id)
+	if kv.Key != "order.id" {
+		t.Errorf("Key = %q, want order.id", kv.Key)
+	}
+	if kv.Value.AsString() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("Value = %q", kv.Value.AsString())
+	}
+}
+
+func TestRecordGenerated(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	ctx, span := tp.Tracer("test").Start(context.Background(), "op")
+
+	id := uuid.MustParse("00000000-0000-7000-8000-000000000001")
+	RecordGenerated(ctx, id)
+	span.End()
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	events := spans[0].Events()
+	if len(events) != 1 || events[0].Name != EventName {
+		t.Fatalf("events = %v, want one %q event", events, EventName)
+	}
+	attrs := make(map[string]string)
+	for _, kv := range events[0].Attributes {
+		attrs[string(kv.Key)] = kv.Value.AsString()
+	}
+	if attrs[string(KeyID)] != id.String() || attrs[string(KeyVersion)] != "V7" {
+		t.Errorf("event attributes = %v", attrs)
+	}
+}
+
+func TestRecordGeneratedWithoutSpan(_ *testing.T) {
+	// Must not panic when ctx carries no span.
+	RecordGenerated(context.Background(), uuid.NewV4())
+}
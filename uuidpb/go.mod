@@ -0,0 +1,8 @@
+module github.com/pscheid92/uuid/uuidpb
+
+go 1.26.0
+
+require (
+	github.com/pscheid92/uuid v0.2.0
+	google.golang.org/protobuf v1.36.11
+)
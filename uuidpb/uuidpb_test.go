@@ -0,0 +1,52 @@
+package uuidpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pscheid92/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+var testUUID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func TestRoundTrip(t *testing.T) {
+	data, err := proto.Marshal(ToProto(testUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 18 {
+		t.Errorf("encoded size = %d, want 18", len(data))
+	}
+	var x UUID
+	if err := proto.Unmarshal(data, &x); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := FromProto(&x); err != nil || got != testUUID {
+		t.Errorf("FromProto() = %s, %v, want %s", got, err, testUUID)
+	}
+	if err := x.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
+
+func TestFromProtoInvalid(t *testing.T) {
+	tests := []struct {
+		x    *UUID
+		want int
+	}{
+		{nil, 0},
+		{&UUID{}, 0},
+		{&UUID{Value: testUUID[:15]}, 15},
+		{&UUID{Value: make([]byte, 17)}, 17},
+	}
+	for _, tt := range tests {
+		_, err := FromProto(tt.x)
+		if lerr, ok := errors.AsType[*uuid.LengthError](err); !ok || lerr.Got != tt.want {
+			t.Errorf("FromProto(%v) error = %v, want *uuid.LengthError with Got %d", tt.x, err, tt.want)
+		}
+		if err := tt.x.Validate(); err == nil {
+			t.Errorf("Validate(%v) = nil, want error", tt.x)
+		}
+	}
+}
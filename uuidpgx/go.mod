@@ -0,0 +1,8 @@
+module github.com/pscheid92/uuid/uuidpgx
+
+go 1.26.0
+
+require (
+	github.com/jackc/pgx/v5 v5.9.2
+	github.com/pscheid92/uuid v0.2.0
+)
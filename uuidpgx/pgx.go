@@ -0,0 +1,110 @@
+// Package uuidpgx registers [uuid.UUID] with pgx v5, so UUIDs travel in
+// PostgreSQL's 16-byte binary wire format instead of round-tripping through
+// the 36-character text form via Scan and Value:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//	    uuidpgx.Register(conn.TypeMap())
+//	    return nil
+//	}
+//
+// After registration uuid.UUID, *uuid.UUID (NULL as nil), and []uuid.UUID
+// scan and encode directly, and rows.Values returns uuid.UUID for uuid
+// columns.
+package uuidpgx
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pscheid92/uuid"
+)
+
+// Register installs [Codec] for the uuid and uuid[] types of m and maps
+// uuid.UUID and []uuid.UUID to them for queries whose parameter types are
+// unknown, such as with the simple protocol.
+func Register(m *pgtype.Map) {
+	t := &pgtype.Type{Name: "uuid", OID: pgtype.UUIDOID, Codec: Codec{}}
+	m.RegisterType(t)
+	m.RegisterType(&pgtype.Type{Name: "_uuid", OID: pgtype.UUIDArrayOID, Codec: &pgtype.ArrayCodec{ElementType: t}})
+	m.RegisterDefaultPgType(uuid.UUID{}, "uuid")
+	m.RegisterDefaultPgType([]uuid.UUID(nil), "_uuid")
+}
+
+// Codec is a pgx codec for the PostgreSQL uuid type that encodes from and
+// scans into uuid.UUID directly, copying the 16 raw bytes in the binary
+// format. Other Go types are handled by the embedded [pgtype.UUIDCodec].
+type Codec struct {
+	pgtype.UUIDCodec
+}
+
+// PlanEncode implements [pgtype.Codec].
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(uuid.UUID); ok {
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return encodeBinary{}
+		case pgtype.TextFormatCode:
+			return encodeText{}
+		}
+	}
+	return c.UUIDCodec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements [pgtype.Codec].
+func (c Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*uuid.UUID); ok {
+		switch format {
+		case pgtype.BinaryFormatCode:
+			return scanBinary{}
+		case pgtype.TextFormatCode:
+			return scanText{}
+		}
+	}
+	return c.UUIDCodec.PlanScan(m, oid, format, target)
+}
+
+// DecodeValue implements [pgtype.Codec]. It returns a uuid.UUID, or nil for
+// NULL.
+func (c Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+	var u uuid.UUID
+	if err := c.PlanScan(m, oid, format, &u).Scan(src, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+var errNull = errors.New("uuidpgx: cannot scan NULL into *uuid.UUID")
+
+type encodeBinary struct{}
+
+func (encodeBinary) Encode(value any, buf []byte) ([]byte, error) {
+	u := value.(uuid.UUID)
+	return append(buf, u[:]...), nil
+}
+
+type encodeText struct{}
+
+func (encodeText) Encode(value any, buf []byte) ([]byte, error) {
+	return value.(uuid.UUID).AppendText(buf)
+}
+
+type scanBinary struct{}
+
+func (scanBinary) Scan(src []byte, dst any) error {
+	if src == nil {
+		return errNull
+	}
+	return dst.(*uuid.UUID).UnmarshalBinary(src)
+}
+
+type scanText struct{}
+
+func (scanText) Scan(src []byte, dst any) error {
+	if src == nil {
+		return errNull
+	}
+	return dst.(*uuid.UUID).UnmarshalText(src)
+}
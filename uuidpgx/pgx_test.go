@@ -0,0 +1,114 @@
+package uuidpgx
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pscheid92/uuid"
+)
+
+var testUUID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestEncode(t *testing.T) {
+	m := newMap()
+	tests := []struct {
+		format int16
+		value  any
+		want   []byte
+	}{
+		{pgtype.BinaryFormatCode, testUUID, testUUID[:]},
+		{pgtype.BinaryFormatCode, &testUUID, testUUID[:]},
+		{pgtype.TextFormatCode, testUUID, []byte(testUUID.String())},
+		{pgtype.BinaryFormatCode, (*uuid.UUID)(nil), nil},
+		{pgtype.BinaryFormatCode, pgtype.UUID{Bytes: testUUID, Valid: true}, testUUID[:]},
+	}
+	for _, tt := range tests {
+		got, err := m.Encode(pgtype.UUIDOID, tt.format, tt.value, nil)
+		if err != nil || !bytes.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+			t.Errorf("Encode(%d, %#v) = %x, %v, want %x", tt.format, tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	m := newMap()
+	for _, tt := range []struct {
+		format int16
+		src    []byte
+	}{
+		{pgtype.BinaryFormatCode, testUUID[:]},
+		{pgtype.TextFormatCode, []byte(testUUID.String())},
+	} {
+		var u uuid.UUID
+		if err := m.Scan(pgtype.UUIDOID, tt.format, tt.src, &u); err != nil || u != testUUID {
+			t.Errorf("Scan(%d) = %s, %v, want %s", tt.format, u, err, testUUID)
+		}
+		p := new(uuid.UUID)
+		if err := m.Scan(pgtype.UUIDOID, tt.format, nil, &p); err != nil || p != nil {
+			t.Errorf("Scan(%d, NULL) into **UUID = %v, %v, want nil", tt.format, p, err)
+		}
+		if err := m.Scan(pgtype.UUIDOID, tt.format, nil, &u); !errors.Is(err, errNull) {
+			t.Errorf("Scan(%d, NULL) into *UUID error = %v, want %v", tt.format, err, errNull)
+		}
+	}
+
+	var u uuid.UUID
+	if _, ok := errors.AsType[*uuid.LengthError](m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, []byte{1, 2}, &u)); !ok {
+		t.Error("Scan(short binary) did not return a *uuid.LengthError")
+	}
+	var s string
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, testUUID[:], &s); err != nil || s != testUUID.String() {
+		t.Errorf("Scan into string = %q, %v", s, err)
+	}
+}
+
+func TestArray(t *testing.T) {
+	m := newMap()
+	ids := []uuid.UUID{testUUID, uuid.Max}
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.UUIDArrayOID, format, ids, nil)
+		if err != nil {
+			t.Fatalf("Encode(%d, []uuid.UUID) error: %v", format, err)
+		}
+		var got []uuid.UUID
+		if err := m.Scan(pgtype.UUIDArrayOID, format, buf, &got); err != nil || !slices.Equal(got, ids) {
+			t.Errorf("Scan(%d) = %v, %v, want %v", format, got, err, ids)
+		}
+	}
+}
+
+func TestDecodeValue(t *testing.T) {
+	m := newMap()
+	typ, ok := m.TypeForOID(pgtype.UUIDOID)
+	if !ok {
+		t.Fatal("uuid type not registered")
+	}
+	v, err := typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.BinaryFormatCode, testUUID[:])
+	if err != nil || v != testUUID {
+		t.Errorf("DecodeValue() = %#v, %v, want uuid.UUID %s", v, err, testUUID)
+	}
+	if v, err := typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.BinaryFormatCode, nil); v != nil || err != nil {
+		t.Errorf("DecodeValue(NULL) = %v, %v, want nil", v, err)
+	}
+	if _, err := typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.TextFormatCode, []byte("bogus")); err == nil {
+		t.Error("DecodeValue(bogus) succeeded, want error")
+	}
+}
+
+func TestDefaultPgType(t *testing.T) {
+	m := newMap()
+	for _, v := range []any{uuid.UUID{}, []uuid.UUID{}} {
+		if typ, ok := m.TypeForValue(v); !ok || (typ.OID != pgtype.UUIDOID && typ.OID != pgtype.UUIDArrayOID) {
+			t.Errorf("TypeForValue(%T) = %v, %v, want uuid type", v, typ, ok)
+		}
+	}
+}